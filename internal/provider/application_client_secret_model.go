@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type applicationClientSecretModel struct {
+	ID                        types.String `tfsdk:"id"`
+	RotationTrigger           types.String `tfsdk:"rotation_trigger"`
+	SQSQueueARN               types.String `tfsdk:"sqs_queue_arn"`
+	NotificationTimeout       types.String `tfsdk:"notification_timeout"`
+	RotatedAt                 types.String `tfsdk:"rotated_at"`
+	ClientID                  types.String `tfsdk:"client_id"`
+	ClientSecret              types.String `tfsdk:"client_secret"`
+	ClientSecretExpiryTime    types.String `tfsdk:"client_secret_expiry_time"`
+	OldClientSecretExpiryTime types.String `tfsdk:"old_client_secret_expiry_time"`
+}
+
+// applicationNewSecretRetention is how long after a rotation Read looks for
+// a new secret notification that Create did not receive. The old secret
+// expires then, and the queue may no longer hold the notification.
+const applicationNewSecretRetention = 7 * 24 * time.Hour
+
+// applicationNewSecretRefreshTimeout is how long Read waits for a new secret
+// notification, which is a single long poll of the queue.
+const applicationNewSecretRefreshTimeout = "25s"
+
+// awaitingNewSecret reports whether the new secret of a rotation has not been
+// received yet and can still be, returning when the secret was rotated.
+func (m applicationClientSecretModel) awaitingNewSecret(now time.Time) (time.Time, bool) {
+	if m.SQSQueueARN.IsNull() || !m.ClientSecret.IsNull() {
+		return time.Time{}, false
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, m.RotatedAt.ValueString())
+	if err != nil || now.Sub(rotatedAt) > applicationNewSecretRetention {
+		return time.Time{}, false
+	}
+
+	return rotatedAt, true
+}
+
+// setNewSecret copies the new secret from its notification.
+func (m *applicationClientSecretModel) setNewSecret(notification *applicationClientNewSecretNotification) {
+	newSecret := notification.Payload.ApplicationOAuthClientNewSecret
+
+	if notification.NotificationMetadata.NotificationID != "" {
+		m.ID = types.StringValue(notification.NotificationMetadata.NotificationID)
+	}
+
+	m.ClientID = types.StringValue(newSecret.ClientID)
+	m.ClientSecret = types.StringValue(newSecret.NewClientSecret)
+	m.ClientSecretExpiryTime = optionalTimeValue(nonZeroTime(newSecret.NewClientSecretExpiryTime))
+	m.OldClientSecretExpiryTime = optionalTimeValue(nonZeroTime(newSecret.OldClientSecretExpiryTime))
+}
+
+// nonZeroTime returns nil for the zero time, which a missing timestamp in a
+// notification decodes to.
+func nonZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &applicationClientSecretResource{}
+	_ resource.ResourceWithConfigure = &applicationClientSecretResource{}
+)
+
+// NewApplicationClientSecretResource is a helper function to simplify the provider implementation.
+func NewApplicationClientSecretResource() resource.Resource {
+	return &applicationClientSecretResource{}
+}
+
+// applicationClientSecretResource is the resource implementation.
+type applicationClientSecretResource struct {
+	grantlessTokens *grantlessTokens
+	region          spapiRegion
+	lwaClientID     string
+}
+
+// Metadata returns the resource type name.
+func (r *applicationClientSecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_client_secret"
+}
+
+func (r *applicationClientSecretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.grantlessTokens = providerData.GrantlessTokens
+	r.region = providerData.Region
+	r.lwaClientID = providerData.LWAClientID
+}
+
+// Schema defines the schema for the resource.
+func (r *applicationClientSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates the LWA client secret of the application whenever rotation_trigger changes. The new secret " +
+			"is delivered with an APPLICATION_OAUTH_CLIENT_NEW_SECRET notification. If sqs_queue_arn is set, the " +
+			"notification is read from that queue, so the new secret can be passed on, e.g. to a secret manager. " +
+			"The old secret stays valid for seven days after a rotation. Destroying the resource does not undo it. " +
+			"If the notification is not received within notification_timeout, the rotation is kept with a warning and " +
+			"the queue is checked again on every refresh for the following seven days.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the new secret notification, or the rotation time if it is not read.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Required: true,
+				Description: "Arbitrary value that rotates the secret when it changes, e.g. the rfc3339 attribute of a " +
+					"time_rotating resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sqs_queue_arn": schema.StringAttribute{
+				Optional: true,
+				Description: "ARN of the SQS queue of a notification destination subscribed to " +
+					"APPLICATION_OAUTH_CLIENT_NEW_SECRET. AWS credentials to receive messages from it are taken from the " +
+					"default credential chain.",
+				Validators: []validator.String{
+					sqsQueueARNValidator{},
+				},
+			},
+			"notification_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("15m"),
+				Description: "How long to wait for the new secret notification, as a Go duration.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The new LWA client secret. Unset if sqs_queue_arn is not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret_expiry_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"old_client_secret_expiry_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create rotates the client secret and sets the initial Terraform state.
+func (r *applicationClientSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationClientSecretModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotatedAt := time.Now().UTC()
+
+	if err := newApplicationManagementClient(r.grantlessTokens, r.region).RotateApplicationClientSecret(ctx); err != nil {
+		resp.Diagnostics.AddError("Error rotating application client secret", err.Error())
+		return
+	}
+
+	// Save the rotation right away, so a failure to read the new secret does
+	// not hide that the old one is about to expire.
+	plan.ID = types.StringValue(rotatedAt.Format(time.RFC3339))
+	plan.RotatedAt = types.StringValue(rotatedAt.Format(time.RFC3339))
+	plan.ClientID = types.StringValue(r.lwaClientID)
+	plan.ClientSecret = types.StringNull()
+	plan.ClientSecretExpiryTime = types.StringNull()
+	plan.OldClientSecretExpiryTime = types.StringNull()
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SQSQueueARN.IsNull() {
+		return
+	}
+
+	// The secret has been rotated, so a failure to receive it is a warning:
+	// an error would taint the resource and rotate the secret again. Read
+	// looks for the notification again while client_secret is unset.
+	notification, err := receiveApplicationClientNewSecret(ctx, plan.SQSQueueARN.ValueString(), r.lwaClientID, rotatedAt, plan.NotificationTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error receiving new application client secret",
+			fmt.Sprintf("The client secret was rotated at %s, the old secret stays valid for seven days. %s. "+
+				"The queue is checked again on the next refresh.", plan.RotatedAt.ValueString(), err),
+		)
+		return
+	}
+
+	plan.setNewSecret(notification)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data. A rotation cannot
+// be looked up, so the state is kept as it is, except that the new secret
+// notification is looked for again if Create did not receive it.
+func (r *applicationClientSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applicationClientSecretModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rotatedAt, ok := state.awaitingNewSecret(time.Now()); ok {
+		notification, err := receiveApplicationClientNewSecret(ctx, state.SQSQueueARN.ValueString(), state.ClientID.ValueString(), rotatedAt, applicationNewSecretRefreshTimeout)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Error receiving new application client secret",
+				fmt.Sprintf("%s. The queue is checked again on the next refresh.", err),
+			)
+		} else {
+			state.setNewSecret(notification)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only the settings for reading the notification can change without another
+// rotation, and they only apply to the next one.
+func (r *applicationClientSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state applicationClientSecretModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.SQSQueueARN = plan.SQSQueueARN
+	state.NotificationTimeout = plan.NotificationTimeout
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the Terraform state. The rotated secret is left as it is.
+func (r *applicationClientSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
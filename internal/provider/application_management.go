@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// applicationClientSecretRotationScope is the scope of the grantless token
+// rotateApplicationClientSecret requires.
+const applicationClientSecretRotationScope = "sellingpartnerapi::client_credential:rotation"
+
+// applicationNotificationClockSkew is how much earlier than the rotation
+// request a new secret notification may be dated and still belong to it.
+const applicationNotificationClockSkew = time.Minute
+
+// applicationManagementClient calls the Application Management API (version
+// 2023-11-30), which the SDK does not generate a client for.
+type applicationManagementClient struct {
+	grantlessTokens *grantlessTokens
+	endpoint        string
+}
+
+func newApplicationManagementClient(grantlessTokens *grantlessTokens, region spapiRegion) *applicationManagementClient {
+	return &applicationManagementClient{
+		grantlessTokens: grantlessTokens,
+		endpoint:        region.Endpoint,
+	}
+}
+
+// RotateApplicationClientSecret requests a new LWA client secret. The secret
+// is not returned, but delivered with an APPLICATION_OAUTH_CLIENT_NEW_SECRET
+// notification. The old secret stays valid for another seven days.
+func (c *applicationManagementClient) RotateApplicationClientSecret(ctx context.Context) error {
+	authorize := c.grantlessTokens.authorizer(applicationClientSecretRotationScope)
+
+	return doSPAPIRequest(ctx, authorize, http.MethodPost, c.endpoint+"/applications/2023-11-30/clientSecret", nil, nil, nil)
+}
+
+// applicationClientNewSecretNotification is an APPLICATION_OAUTH_CLIENT_NEW_SECRET
+// notification as delivered to an SQS destination.
+type applicationClientNewSecretNotification struct {
+	NotificationType string    `json:"notificationType"`
+	EventTime        time.Time `json:"eventTime"`
+	Payload          struct {
+		ApplicationOAuthClientNewSecret struct {
+			ClientID                  string    `json:"clientId"`
+			NewClientSecret           string    `json:"newClientSecret"`
+			NewClientSecretExpiryTime time.Time `json:"newClientSecretExpiryTime"`
+			OldClientSecretExpiryTime time.Time `json:"oldClientSecretExpiryTime"`
+		} `json:"applicationOAuthClientNewSecret"`
+	} `json:"payload"`
+	NotificationMetadata struct {
+		ApplicationID  string `json:"applicationId"`
+		SubscriptionID string `json:"subscriptionId"`
+		NotificationID string `json:"notificationId"`
+	} `json:"notificationMetadata"`
+}
+
+// parseApplicationClientNewSecretNotification decodes an SQS message body and
+// reports whether it is a new secret notification for clientID dated no
+// earlier than since. Any other message is not an error, as the queue is
+// usually shared with other notification types.
+func parseApplicationClientNewSecretNotification(body string, clientID string, since time.Time) (*applicationClientNewSecretNotification, bool) {
+	var notification applicationClientNewSecretNotification
+	if err := json.Unmarshal([]byte(body), &notification); err != nil {
+		return nil, false
+	}
+
+	newSecret := notification.Payload.ApplicationOAuthClientNewSecret
+
+	if notification.NotificationType != "APPLICATION_OAUTH_CLIENT_NEW_SECRET" || newSecret.NewClientSecret == "" {
+		return nil, false
+	}
+
+	if clientID != "" && newSecret.ClientID != clientID {
+		return nil, false
+	}
+
+	if notification.EventTime.Before(since.Add(-applicationNotificationClockSkew)) {
+		return nil, false
+	}
+
+	return &notification, true
+}
+
+// receiveApplicationClientNewSecret long polls an SQS queue until it receives
+// the new secret notification for clientID sent after since, and deletes that
+// message. Other messages stay hidden while polling, so that each is received
+// only once, and are made visible again when it returns, so their consumers
+// still get them. AWS credentials are taken from the default credential chain.
+func receiveApplicationClientNewSecret(ctx context.Context, queueARN string, clientID string, since time.Time, timeout string) (*applicationClientNewSecretNotification, error) {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notification_timeout %q: %w", timeout, err)
+	}
+
+	arn, err := parseSQSQueueARN(queueARN)
+	if err != nil {
+		return nil, err
+	}
+
+	awsConfig, err := config.LoadDefaultConfig(ctx, config.WithRegion(arn.Region))
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS configuration: %w", err)
+	}
+
+	client := sqs.NewFromConfig(awsConfig)
+
+	queueURL, err := client.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName:              aws.String(arn.QueueName),
+		QueueOwnerAWSAccountId: aws.String(arn.AccountID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting URL of queue %s: %w", queueARN, err)
+	}
+
+	var received []*string
+	defer func() {
+		// The context may be done already, the messages are released anyway.
+		ctx := context.WithoutCancel(ctx)
+
+		for _, receiptHandle := range received {
+			if _, err := client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+				QueueUrl:          queueURL.QueueUrl,
+				ReceiptHandle:     receiptHandle,
+				VisibilityTimeout: 0,
+			}); err != nil {
+				// The message becomes visible again once its visibility timeout expires.
+				tflog.Warn(ctx, "Error releasing SQS message", map[string]interface{}{"error": err.Error()})
+			}
+		}
+	}()
+
+	deadline := time.Now().Add(duration)
+
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	for {
+		output, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            queueURL.QueueUrl,
+			MaxNumberOfMessages: 10,
+			VisibilityTimeout:   sqsVisibilityTimeout(deadline),
+			WaitTimeSeconds:     20,
+		})
+		if errors.Is(err, context.DeadlineExceeded) || (err != nil && ctx.Err() != nil) {
+			return nil, fmt.Errorf("no APPLICATION_OAUTH_CLIENT_NEW_SECRET notification was received on %s within %s", queueARN, duration)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("receiving messages from %s: %w", queueARN, err)
+		}
+
+		for _, message := range output.Messages {
+			notification, ok := parseApplicationClientNewSecretNotification(aws.ToString(message.Body), clientID, since)
+			if !ok {
+				received = append(received, message.ReceiptHandle)
+				continue
+			}
+
+			if _, err := client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+				QueueUrl:      queueURL.QueueUrl,
+				ReceiptHandle: message.ReceiptHandle,
+			}); err != nil {
+				// The secret has been received, a redelivery of the message is harmless.
+				tflog.Warn(ctx, "Error deleting new client secret notification", map[string]interface{}{"error": err.Error()})
+			}
+
+			return notification, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for new client secret notification", map[string]interface{}{"queue_arn": queueARN, "messages": len(output.Messages)})
+	}
+}
+
+// sqsVisibilityTimeout returns the visibility timeout in seconds that hides
+// received messages until deadline, within the limits of SQS.
+func sqsVisibilityTimeout(deadline time.Time) int32 {
+	seconds := int32(math.Ceil(time.Until(deadline).Seconds())) + 1
+
+	return max(1, min(seconds, 12*60*60))
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseApplicationClientNewSecretNotification(t *testing.T) {
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	body := readTestdata(t, "application_oauth_client_new_secret.json")
+
+	notification, ok := parseApplicationClientNewSecretNotification(string(body), "amzn1.application-oa2-client.example", since)
+	if !ok {
+		t.Fatal("notification was not recognized")
+	}
+
+	newSecret := notification.Payload.ApplicationOAuthClientNewSecret
+	if newSecret.NewClientSecret != "amzn1.oa2-cs.v1.new" || notification.NotificationMetadata.NotificationID != "a1b2c3d4-0000-0000-0000-000000000000" {
+		t.Errorf("unexpected notification: %+v", notification)
+	}
+
+	model := applicationClientSecretModel{}
+	model.setNewSecret(notification)
+
+	if model.ClientSecretExpiryTime.ValueString() != "2024-11-27T12:00:00Z" || model.OldClientSecretExpiryTime.ValueString() != "2024-05-08T12:00:00Z" {
+		t.Errorf("unexpected expiry times %s and %s", model.ClientSecretExpiryTime, model.OldClientSecretExpiryTime)
+	}
+
+	if _, ok := parseApplicationClientNewSecretNotification(string(body), "amzn1.application-oa2-client.other", since); ok {
+		t.Error("notification of another client was recognized")
+	}
+
+	if _, ok := parseApplicationClientNewSecretNotification(string(body), "", since.Add(time.Hour)); ok {
+		t.Error("notification of an earlier rotation was recognized")
+	}
+
+	for _, other := range []string{
+		"not json",
+		`{"notificationType":"ANY_OFFER_CHANGED","eventTime":"2024-05-01T12:00:00Z","payload":{}}`,
+		`{"notificationType":"APPLICATION_OAUTH_CLIENT_NEW_SECRET","eventTime":"2024-05-01T12:00:00Z","payload":{}}`,
+	} {
+		if _, ok := parseApplicationClientNewSecretNotification(other, "", since); ok {
+			t.Errorf("message %q was recognized", other)
+		}
+	}
+}
+
+func TestApplicationClientSecretAwaitingNewSecret(t *testing.T) {
+	rotatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	model := applicationClientSecretModel{
+		SQSQueueARN:  types.StringValue("arn:aws:sqs:us-east-1:123456789012:spapi-notifications"),
+		RotatedAt:    types.StringValue(rotatedAt.Format(time.RFC3339)),
+		ClientSecret: types.StringNull(),
+	}
+
+	if got, ok := model.awaitingNewSecret(rotatedAt.Add(time.Hour)); !ok || !got.Equal(rotatedAt) {
+		t.Errorf("awaitingNewSecret = %s, %t, want the rotation time", got, ok)
+	}
+
+	if _, ok := model.awaitingNewSecret(rotatedAt.Add(8 * 24 * time.Hour)); ok {
+		t.Error("still awaiting the new secret after the old one expired")
+	}
+
+	received := model
+	received.ClientSecret = types.StringValue("amzn1.oa2-cs.v1.new")
+	if _, ok := received.awaitingNewSecret(rotatedAt.Add(time.Hour)); ok {
+		t.Error("still awaiting a received secret")
+	}
+
+	withoutQueue := model
+	withoutQueue.SQSQueueARN = types.StringNull()
+	if _, ok := withoutQueue.awaitingNewSecret(rotatedAt.Add(time.Hour)); ok {
+		t.Error("awaiting a secret without a queue to read it from")
+	}
+}
+
+func TestSQSVisibilityTimeout(t *testing.T) {
+	for _, test := range []struct {
+		deadline time.Duration
+		want     int32
+	}{
+		{deadline: -time.Minute, want: 1},
+		{deadline: 5 * time.Minute, want: 301},
+		{deadline: 24 * time.Hour, want: 12 * 60 * 60},
+	} {
+		if got := sqsVisibilityTimeout(time.Now().Add(test.deadline)); got != test.want {
+			t.Errorf("sqsVisibilityTimeout(now + %s) = %d, want %d", test.deadline, got, test.want)
+		}
+	}
+}
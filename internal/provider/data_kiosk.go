@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// dataKioskQueryDoneStatuses are the processingStatus values of a Data Kiosk
+// query that will not change anymore.
+var dataKioskQueryDoneStatuses = []string{"DONE", "FATAL", "CANCELLED"}
+
+// dataKioskClient calls the Data Kiosk API (version 2023-11-15), which the
+// SDK does not generate a client for.
+type dataKioskClient struct {
+	sellingPartner *sp.SellingPartner
+	endpoint       string
+}
+
+func newDataKioskClient(sellingPartner *sp.SellingPartner, region spapiRegion) *dataKioskClient {
+	return &dataKioskClient{
+		sellingPartner: sellingPartner,
+		endpoint:       region.Endpoint,
+	}
+}
+
+type dataKioskQuery struct {
+	QueryID             string     `json:"queryId"`
+	Query               string     `json:"query"`
+	CreatedTime         time.Time  `json:"createdTime"`
+	ProcessingStatus    string     `json:"processingStatus"`
+	ProcessingStartTime *time.Time `json:"processingStartTime"`
+	ProcessingEndTime   *time.Time `json:"processingEndTime"`
+	DataDocumentID      string     `json:"dataDocumentId"`
+	ErrorDocumentID     string     `json:"errorDocumentId"`
+	Pagination          *struct {
+		NextToken string `json:"nextToken"`
+	} `json:"pagination"`
+}
+
+type dataKioskDocument struct {
+	DocumentID  string `json:"documentId"`
+	DocumentURL string `json:"documentUrl"`
+}
+
+// CreateQuery submits a GraphQL query, returning the query ID.
+func (c *dataKioskClient) CreateQuery(ctx context.Context, query string) (string, error) {
+	body := map[string]string{"query": query}
+
+	var result struct {
+		QueryID string `json:"queryId"`
+	}
+
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodPost, c.endpoint+"/dataKiosk/2023-11-15/queries", nil, body, &result); err != nil {
+		return "", err
+	}
+
+	return result.QueryID, nil
+}
+
+// GetQuery returns a query, or an spapiResponseError when it does not exist.
+func (c *dataKioskClient) GetQuery(ctx context.Context, queryID string) (*dataKioskQuery, error) {
+	var query dataKioskQuery
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/dataKiosk/2023-11-15/queries/"+url.PathEscape(queryID), nil, nil, &query); err != nil {
+		return nil, err
+	}
+
+	return &query, nil
+}
+
+// CancelQuery cancels a query that has not started processing yet.
+func (c *dataKioskClient) CancelQuery(ctx context.Context, queryID string) error {
+	return doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodDelete, c.endpoint+"/dataKiosk/2023-11-15/queries/"+url.PathEscape(queryID), nil, nil, nil)
+}
+
+// GetDocument returns where a data or error document can be downloaded from.
+func (c *dataKioskClient) GetDocument(ctx context.Context, documentID string) (*dataKioskDocument, error) {
+	var document dataKioskDocument
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/dataKiosk/2023-11-15/documents/"+url.PathEscape(documentID), nil, nil, &document); err != nil {
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+// waitForDataKioskQuery polls GetQuery until the query is done processing or
+// the timeout expires.
+func waitForDataKioskQuery(ctx context.Context, client *dataKioskClient, queryID string, timeout string) (*dataKioskQuery, error) {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid processing_timeout %q: %w", timeout, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	// getQuery allows two requests per second after a burst of 15, but
+	// queries take minutes, so there is no point in polling that often.
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		query, err := client.GetQuery(ctx, queryID)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(dataKioskQueryDoneStatuses, query.ProcessingStatus) {
+			return query, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for Data Kiosk query processing", map[string]interface{}{"query_id": queryID, "processing_status": query.ProcessingStatus})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("query %s did not finish processing within %s", queryID, duration)
+		case <-ticker.C:
+		}
+	}
+}
+
+// dataKioskRows splits a JSONL document into one compact JSON object per
+// line. Blank lines are skipped.
+func dataKioskRows(content []byte) ([]string, error) {
+	rows := []string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var row bytes.Buffer
+		if err := json.Compact(&row, text); err != nil {
+			return nil, fmt.Errorf("line %d is not valid JSON: %w", line, err)
+		}
+
+		rows = append(rows, row.String())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataKioskQueryModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Query             types.String   `tfsdk:"query"`
+	OutputPath        types.String   `tfsdk:"output_path"`
+	ProcessingTimeout types.String   `tfsdk:"processing_timeout"`
+	ProcessingStatus  types.String   `tfsdk:"processing_status"`
+	CreatedTime       types.String   `tfsdk:"created_time"`
+	ProcessingEndTime types.String   `tfsdk:"processing_end_time"`
+	DataDocumentID    types.String   `tfsdk:"data_document_id"`
+	ErrorDocumentID   types.String   `tfsdk:"error_document_id"`
+	NextToken         types.String   `tfsdk:"next_token"`
+	OutputSHA256      types.String   `tfsdk:"output_sha256"`
+	Rows              []types.String `tfsdk:"rows"`
+}
+
+// setQuery copies the processing details of a query into the model.
+func (m *dataKioskQueryModel) setQuery(query *dataKioskQuery) {
+	m.ID = types.StringValue(query.QueryID)
+	m.ProcessingStatus = types.StringValue(query.ProcessingStatus)
+	m.CreatedTime = types.StringValue(query.CreatedTime.Format(time.RFC3339))
+	m.ProcessingEndTime = optionalTimeValue(query.ProcessingEndTime)
+	m.DataDocumentID = optionalStringValue(query.DataDocumentID)
+	m.ErrorDocumentID = optionalStringValue(query.ErrorDocumentID)
+
+	m.NextToken = types.StringNull()
+	if query.Pagination != nil {
+		m.NextToken = optionalStringValue(query.Pagination.NextToken)
+	}
+}
+
+func optionalStringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
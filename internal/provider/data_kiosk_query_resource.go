@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dataKioskQueryResource{}
+	_ resource.ResourceWithConfigure      = &dataKioskQueryResource{}
+	_ resource.ResourceWithValidateConfig = &dataKioskQueryResource{}
+)
+
+// NewDataKioskQueryResource is a helper function to simplify the provider implementation.
+func NewDataKioskQueryResource() resource.Resource {
+	return &dataKioskQueryResource{}
+}
+
+// dataKioskQueryResource is the resource implementation.
+type dataKioskQueryResource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+// Metadata returns the resource type name.
+func (r *dataKioskQueryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_kiosk_query"
+}
+
+func (r *dataKioskQueryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.sellingPartner = providerData.SellingPartner
+	r.region = providerData.Region
+}
+
+// Schema defines the schema for the resource.
+func (r *dataKioskQueryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Data Kiosk GraphQL query and downloads its JSONL result document. A change of the query " +
+			"runs a new one. A query that fails or is still being processed after processing_timeout is kept with a " +
+			"warning, so it is not run again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the query.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query": schema.StringAttribute{
+				Required: true,
+				Description: "GraphQL query, e.g. a query of analytics_salesAndTraffic_2023_11_15. It is checked for " +
+					"balanced brackets, and the datasets it uses against a snapshot bundled with the provider, which " +
+					"warns about datasets it does not know. Amazon validates everything else.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Path of a file to stream the result document to, instead of returning it as rows. Use it " +
+					"for large results, which should not be kept in the Terraform state.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"processing_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("30m"),
+				Description: "How long to wait for the query to be processed, as a Go duration.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"processing_status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"processing_end_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_document_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the result document. Unset if the query returned no data.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"error_document_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"next_token": schema.StringAttribute{
+				Computed:    true,
+				Description: "Pagination token of the next page of results, to run as another query. Unset on the last page.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 checksum of the file written to output_path.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rows": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Lines of the result document, each a JSON object, unless output_path is set.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *dataKioskQueryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var query types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("query"), &query)...)
+	if resp.Diagnostics.HasError() || query.IsNull() || query.IsUnknown() {
+		return
+	}
+
+	warnings, err := validateDataKioskQuery(query.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("query"), "Invalid Data Kiosk query", err.Error())
+		return
+	}
+
+	for _, warning := range warnings {
+		resp.Diagnostics.AddAttributeWarning(path.Root("query"), "Data Kiosk query does not match the schema snapshot", warning)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dataKioskQueryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dataKioskQueryModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDataKioskClient(r.sellingPartner, r.region)
+
+	queryID, err := client.CreateQuery(ctx, plan.Query.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Data Kiosk query", err.Error())
+		return
+	}
+
+	// Save the query right away, so it is not lost if processing times out.
+	plan.ID = types.StringValue(queryID)
+	plan.ProcessingStatus = types.StringValue("IN_QUEUE")
+	plan.CreatedTime = types.StringNull()
+	plan.ProcessingEndTime = types.StringNull()
+	plan.DataDocumentID = types.StringNull()
+	plan.ErrorDocumentID = types.StringNull()
+	plan.NextToken = types.StringNull()
+	plan.OutputSHA256 = types.StringNull()
+	plan.Rows = nil
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The query has been created, so errors from here on are warnings: an
+	// error would taint the resource and run the query a second time. Read
+	// picks up the result of a query that is still being processed.
+	query, err := waitForDataKioskQuery(ctx, client, queryID, plan.ProcessingTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Data Kiosk query processing not finished",
+			fmt.Sprintf("%s. The query is kept and its result is read on the next refresh.", err),
+		)
+		return
+	}
+
+	result := plan
+	result.setQuery(query)
+
+	// A result that cannot be fetched now leaves the query IN_QUEUE in the
+	// state, so Read fetches it again.
+	for _, d := range r.fetchResult(ctx, client, query, &result) {
+		if d.Severity() == diag.SeverityError {
+			resp.Diagnostics.AddWarning(d.Summary(), d.Detail()+"\n\nThe query is kept and its result is read on the next refresh.")
+			return
+		}
+
+		resp.Diagnostics.Append(d)
+	}
+
+	plan = result
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// fetchResult downloads the result document of a processed query into rows
+// or output_path, or reports the error document of a failed one as a warning.
+// Errors mean the result could not be fetched and should be tried again.
+func (r *dataKioskQueryResource) fetchResult(ctx context.Context, client *dataKioskClient, query *dataKioskQuery, model *dataKioskQueryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if query.ProcessingStatus != "DONE" {
+		detail := fmt.Sprintf("Query %s finished with status %s.", query.QueryID, query.ProcessingStatus)
+
+		if query.ErrorDocumentID != "" {
+			content, err := r.downloadDocument(ctx, client, query.ErrorDocumentID)
+			if err != nil {
+				diags.AddWarning("Error getting Data Kiosk error document", err.Error())
+			} else {
+				detail += "\n\n" + string(content)
+			}
+		}
+
+		diags.AddWarning("Data Kiosk query processing failed", detail)
+		return diags
+	}
+
+	// A query without data has no result document.
+	if query.DataDocumentID == "" {
+		if model.OutputPath.IsNull() {
+			model.Rows = []types.String{}
+		}
+
+		return diags
+	}
+
+	if !model.OutputPath.IsNull() {
+		document, err := client.GetDocument(ctx, query.DataDocumentID)
+		if err != nil {
+			diags.AddError("Error getting Data Kiosk document", err.Error())
+			return diags
+		}
+
+		checksum, err := saveDocument(ctx, document.DocumentURL, "", model.OutputPath.ValueString())
+		if err != nil {
+			diags.AddError("Error saving Data Kiosk document", err.Error())
+			return diags
+		}
+
+		model.OutputSHA256 = types.StringValue(checksum)
+		return diags
+	}
+
+	content, err := r.downloadDocument(ctx, client, query.DataDocumentID)
+	if err != nil {
+		diags.AddError("Error getting Data Kiosk document", err.Error())
+		return diags
+	}
+
+	rows, err := dataKioskRows(content)
+	if err != nil {
+		diags.AddError("Error parsing Data Kiosk document", err.Error())
+		return diags
+	}
+
+	model.Rows = make([]types.String, 0, len(rows))
+	for _, row := range rows {
+		model.Rows = append(model.Rows, types.StringValue(row))
+	}
+
+	return diags
+}
+
+func (r *dataKioskQueryResource) downloadDocument(ctx context.Context, client *dataKioskClient, documentID string) ([]byte, error) {
+	document, err := client.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return downloadDocument(ctx, document.DocumentURL)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dataKioskQueryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataKioskQueryModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The result of a processed query does not change, and is fetched only
+	// once.
+	if slices.Contains(dataKioskQueryDoneStatuses, state.ProcessingStatus.ValueString()) {
+		return
+	}
+
+	client := newDataKioskClient(r.sellingPartner, r.region)
+
+	query, err := client.GetQuery(ctx, state.ID.ValueString())
+	if isSPAPINotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting Data Kiosk query", err.Error())
+		return
+	}
+
+	state.setQuery(query)
+
+	// The result of a query that finished after a timed out apply is fetched
+	// now.
+	if slices.Contains(dataKioskQueryDoneStatuses, query.ProcessingStatus) {
+		resp.Diagnostics.Append(r.fetchResult(ctx, client, query, &state)...)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dataKioskQueryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dataKioskQueryModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The query and output path require a replacement, so only the timeout
+	// can change in place.
+	state.ProcessingTimeout = plan.ProcessingTimeout
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete cancels the query if it is still queued and removes the Terraform
+// state. The result document is left to expire.
+func (r *dataKioskQueryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataKioskQueryModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if slices.Contains(dataKioskQueryDoneStatuses, state.ProcessingStatus.ValueString()) {
+		return
+	}
+
+	client := newDataKioskClient(r.sellingPartner, r.region)
+
+	// Only queries that are still IN_QUEUE can be cancelled, anything else is
+	// already being processed and is left to finish.
+	err := client.CancelQuery(ctx, state.ID.ValueString())
+	if respErr, ok := err.(*spapiResponseError); ok && (respErr.StatusCode == http.StatusBadRequest || respErr.StatusCode == http.StatusNotFound) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error cancelling Data Kiosk query", err.Error())
+		return
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// dataKioskDatasets is a snapshot of the Data Kiosk datasets. Datasets are
+// versioned and never change, but new ones are released, so a dataset missing
+// from the snapshot is only reported as a warning.
+var dataKioskDatasets = []string{
+	"analytics_economics_2024_03_15",
+	"analytics_salesAndTraffic_2023_11_15",
+	"analytics_vendorAnalytics_2024_09_30",
+}
+
+// dataKioskDatasetPattern matches dataset names, which end with the date of
+// their version.
+var dataKioskDatasetPattern = regexp.MustCompile(`\b[A-Za-z]+_[A-Za-z]+_\d{4}_\d{2}_\d{2}\b`)
+
+// validateDataKioskQuery checks that a Data Kiosk query is a query with
+// balanced brackets and that the datasets it uses are in the bundled
+// snapshot. It is not a GraphQL parser: createQuery reports anything else. A
+// malformed query returns an error; an unknown dataset is returned as a
+// warning.
+func validateDataKioskQuery(query string) ([]string, error) {
+	code, err := stripGraphQLLiterals(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if operation, _, _ := strings.Cut(strings.TrimSpace(code), "{"); strings.HasPrefix(operation, "mutation") || strings.HasPrefix(operation, "subscription") {
+		return nil, fmt.Errorf("Data Kiosk only supports queries, not %s", strings.Fields(operation)[0])
+	}
+
+	var open []rune
+	for _, c := range code {
+		switch c {
+		case '{', '(', '[':
+			open = append(open, c)
+		case '}', ')', ']':
+			want := map[rune]rune{'}': '{', ')': '(', ']': '['}[c]
+			if len(open) == 0 || open[len(open)-1] != want {
+				return nil, fmt.Errorf("unexpected %q", c)
+			}
+
+			open = open[:len(open)-1]
+		}
+	}
+
+	if len(open) > 0 {
+		return nil, fmt.Errorf("unclosed %q", open[len(open)-1])
+	}
+
+	warnings := []string{}
+	unknown := []string{}
+
+	for _, dataset := range dataKioskDatasetPattern.FindAllString(code, -1) {
+		if slices.Contains(dataKioskDatasets, dataset) || slices.Contains(unknown, dataset) {
+			continue
+		}
+
+		unknown = append(unknown, dataset)
+		warnings = append(warnings, fmt.Sprintf("Dataset %s is not in the bundled schema snapshot, which may be outdated.", dataset))
+	}
+
+	return warnings, nil
+}
+
+// stripGraphQLLiterals blanks out the strings and comments of a GraphQL
+// document, so that brackets and names inside them are not checked.
+func stripGraphQLLiterals(document string) (string, error) {
+	var code strings.Builder
+
+	for i := 0; i < len(document); {
+		switch {
+		case document[i] == '#':
+			for i < len(document) && document[i] != '\n' && document[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(document[i:], `"""`):
+			end := strings.Index(document[i+3:], `"""`)
+			if end < 0 {
+				return "", fmt.Errorf("unterminated block string")
+			}
+
+			i += 3 + end + 3
+			code.WriteString(`""`)
+		case document[i] == '"':
+			i++
+			for i < len(document) && document[i] != '"' && document[i] != '\n' {
+				if document[i] == '\\' {
+					i++
+				}
+				i++
+			}
+
+			if i >= len(document) || document[i] != '"' {
+				return "", fmt.Errorf("unterminated string")
+			}
+
+			i++
+			code.WriteString(`""`)
+		default:
+			code.WriteByte(document[i])
+			i++
+		}
+	}
+
+	return code.String(), nil
+}
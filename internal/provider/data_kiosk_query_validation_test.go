@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateDataKioskQuery(t *testing.T) {
+	tests := map[string]struct {
+		query    string
+		warnings []string
+	}{
+		"sales and traffic": {
+			query: `query MyQuery($start: Date!) {
+				# Sales by day, see analytics_salesAndTraffic_2020_01_01 {
+				analytics_salesAndTraffic_2023_11_15 {
+					salesAndTrafficByDate(
+						startDate: $start
+						endDate: "2024-01-31"
+						aggregateBy: DAY
+						marketplaceIds: ["ATVPDKIKX0DER"]
+					) @include(if: true) {
+						startDate
+						sales { orderedProductSales { amount currencyCode } }
+						...Traffic
+					}
+				}
+			}
+
+			fragment Traffic on SalesAndTrafficByDate {
+				traffic { pageViews }
+			}`,
+			warnings: []string{},
+		},
+		"anonymous query with inline fragment": {
+			query:    `{ analytics_economics_2024_03_15 { ... on Query { economics(startDate: "2024-03-01", endDate: "2024-03-31", marketplaceIds: ["A1PA6795UKMFR9"], aggregateBy: {date: MONTH, productId: MSKU}) { msku } } } }`,
+			warnings: []string{},
+		},
+		"brackets in strings": {
+			query:    `query { analytics_salesAndTraffic_2023_11_15 { salesAndTrafficByAsin(startDate: "2024-01-01 {", endDate: """ ) """, aggregateBy: SKU) { parentAsin } } }`,
+			warnings: []string{},
+		},
+		"unknown dataset": {
+			query: `query { analytics_newDataset_2030_01_01 { rows { id } } analytics_newDataset_2030_01_01 { more { id } } }`,
+			warnings: []string{
+				"Dataset analytics_newDataset_2030_01_01 is not in the bundled schema snapshot, which may be outdated.",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			warnings, err := validateDataKioskQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(warnings, test.warnings) {
+				t.Errorf("got warnings %q, want %q", warnings, test.warnings)
+			}
+		})
+	}
+}
+
+func TestValidateDataKioskQueryErrors(t *testing.T) {
+	tests := map[string]string{
+		"unbalanced":          `query { analytics_salesAndTraffic_2023_11_15 { salesAndTrafficByDate(startDate: "2024-01-01") { startDate }`,
+		"mismatched":          `query { a(b: [1, 2) { c } }`,
+		"mutation":            `mutation { createThing { id } }`,
+		"subscription":        `subscription Changes { changes { id } }`,
+		"unterminated string": `query { a(b: "c) { d } }`,
+		"unterminated block":  `query { a(b: """c) { d } }`,
+	}
+
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := validateDataKioskQuery(query); err == nil {
+				t.Error("got no error")
+			}
+		})
+	}
+}
+
+func TestDataKioskRows(t *testing.T) {
+	content := "{\"startDate\": \"2024-01-01\", \"sales\": {\"units\": 3}}\n\n{\"startDate\":\"2024-01-02\"}\r\n"
+
+	rows, err := dataKioskRows([]byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{`{"startDate":"2024-01-01","sales":{"units":3}}`, `{"startDate":"2024-01-02"}`}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("got %q, want %q", rows, want)
+	}
+
+	if _, err := dataKioskRows([]byte("{\"a\": 1}\n{not json}\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("got error %v, want one about line 2", err)
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDurationValidator(t *testing.T) {
+	tests := map[string]struct {
+		value types.String
+		error bool
+	}{
+		"null":     {value: types.StringNull()},
+		"unknown":  {value: types.StringUnknown()},
+		"minutes":  {value: types.StringValue("15m")},
+		"combined": {value: types.StringValue("1h30m")},
+		"days":     {value: types.StringValue("1d"), error: true},
+		"no unit":  {value: types.StringValue("30"), error: true},
+		"zero":     {value: types.StringValue("0s"), error: true},
+		"negative": {value: types.StringValue("-5m"), error: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			durationValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("timeout"),
+				ConfigValue: test.value,
+			}, resp)
+
+			if resp.Diagnostics.HasError() != test.error {
+				t.Errorf("got diagnostics %v", resp.Diagnostics)
+			}
+		})
+	}
+}
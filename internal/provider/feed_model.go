@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type feedModel struct {
+	ID                    types.String            `tfsdk:"id"`
+	FeedType              types.String            `tfsdk:"feed_type"`
+	MarketplaceIDs        []types.String          `tfsdk:"marketplace_ids"`
+	Content               types.String            `tfsdk:"content"`
+	ContentFile           types.String            `tfsdk:"content_file"`
+	ContentHash           types.String            `tfsdk:"content_hash"`
+	ContentType           types.String            `tfsdk:"content_type"`
+	FeedOptions           map[string]types.String `tfsdk:"feed_options"`
+	ProcessingTimeout     types.String            `tfsdk:"processing_timeout"`
+	FailOnProcessingError types.Bool              `tfsdk:"fail_on_processing_error"`
+	ProcessingStatus      types.String            `tfsdk:"processing_status"`
+	CreatedTime           types.String            `tfsdk:"created_time"`
+	ProcessingStartTime   types.String            `tfsdk:"processing_start_time"`
+	ProcessingEndTime     types.String            `tfsdk:"processing_end_time"`
+	ResultFeedDocumentID  types.String            `tfsdk:"result_feed_document_id"`
+	ProcessingReport      types.String            `tfsdk:"processing_report"`
+	MessagesProcessed     types.Int64             `tfsdk:"messages_processed"`
+	MessagesAccepted      types.Int64             `tfsdk:"messages_accepted"`
+	ErrorCount            types.Int64             `tfsdk:"error_count"`
+	WarningCount          types.Int64             `tfsdk:"warning_count"`
+	ProcessingIssues      []feedIssueModel        `tfsdk:"processing_issues"`
+}
+
+type feedIssueModel struct {
+	MessageID types.Int64  `tfsdk:"message_id"`
+	SKU       types.String `tfsdk:"sku"`
+	Severity  types.String `tfsdk:"severity"`
+	Code      types.String `tfsdk:"code"`
+	Message   types.String `tfsdk:"message"`
+}
+
+func (m feedModel) marketplaceIDs() []string {
+	ids := make([]string, 0, len(m.MarketplaceIDs))
+	for _, id := range m.MarketplaceIDs {
+		ids = append(ids, id.ValueString())
+	}
+
+	return ids
+}
+
+func (m feedModel) feedOptions() map[string]string {
+	options := make(map[string]string, len(m.FeedOptions))
+	for key, value := range m.FeedOptions {
+		options[key] = value.ValueString()
+	}
+
+	return options
+}
+
+// setFeed copies the processing details of a feed into the model.
+func (m *feedModel) setFeed(feed *feed) {
+	m.ID = types.StringValue(feed.FeedID)
+	m.ProcessingStatus = types.StringValue(feed.ProcessingStatus)
+	m.CreatedTime = types.StringValue(feed.CreatedTime.Format(time.RFC3339))
+	m.ProcessingStartTime = optionalTimeValue(feed.ProcessingStartTime)
+	m.ProcessingEndTime = optionalTimeValue(feed.ProcessingEndTime)
+
+	m.ResultFeedDocumentID = types.StringPointerValue(feed.ResultFeedDocumentID)
+}
+
+// setProcessingReport stores the processing report and its parsed outcome.
+// The report is kept even if it cannot be parsed, in which case the parse
+// error is returned and the outcome is left unset.
+func (m *feedModel) setProcessingReport(content []byte) (*feedProcessingReport, error) {
+	m.clearProcessingReport()
+	m.ProcessingReport = types.StringValue(string(content))
+
+	report, err := parseFeedProcessingReport(content)
+	if err != nil {
+		return nil, err
+	}
+
+	m.MessagesProcessed = types.Int64Value(report.MessagesProcessed)
+	m.MessagesAccepted = types.Int64Value(report.MessagesAccepted)
+	m.ErrorCount = types.Int64Value(report.Errors)
+	m.WarningCount = types.Int64Value(report.Warnings)
+	m.ProcessingIssues = []feedIssueModel{}
+
+	for _, issue := range report.Issues {
+		model := feedIssueModel{
+			MessageID: types.Int64Null(),
+			SKU:       types.StringNull(),
+			Severity:  types.StringValue(issue.Severity),
+			Code:      types.StringValue(issue.Code),
+			Message:   types.StringValue(issue.Message),
+		}
+
+		if issue.MessageID != 0 {
+			model.MessageID = types.Int64Value(issue.MessageID)
+		}
+
+		if issue.SKU != "" {
+			model.SKU = types.StringValue(issue.SKU)
+		}
+
+		m.ProcessingIssues = append(m.ProcessingIssues, model)
+	}
+
+	return report, nil
+}
+
+// clearProcessingReport unsets the processing report and its outcome.
+func (m *feedModel) clearProcessingReport() {
+	m.ProcessingReport = types.StringNull()
+	m.MessagesProcessed = types.Int64Null()
+	m.MessagesAccepted = types.Int64Null()
+	m.ErrorCount = types.Int64Null()
+	m.WarningCount = types.Int64Null()
+	m.ProcessingIssues = nil
+}
+
+func optionalTimeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.Format(time.RFC3339))
+}
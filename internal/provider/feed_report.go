@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// feedProcessingReport is the outcome of a feed as read from its processing
+// report, whatever the format of the report.
+type feedProcessingReport struct {
+	MessagesProcessed int64
+	MessagesAccepted  int64
+	Errors            int64
+	Warnings          int64
+	Issues            []feedProcessingIssue
+}
+
+// feedProcessingIssue is an error or warning about one message, or record,
+// of a feed. MessageID is 0 for issues about the feed as a whole.
+type feedProcessingIssue struct {
+	MessageID int64
+	SKU       string
+	Severity  string
+	Code      string
+	Message   string
+}
+
+// parseFeedProcessingReport parses the processing report of a feed. JSON
+// listings feed reports, XML ProcessingReport envelopes and tab-separated
+// flat file reports are supported; the format is detected from the content.
+func parseFeedProcessingReport(content []byte) (*feedProcessingReport, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	trimmed := bytes.TrimSpace(content)
+
+	switch {
+	case len(trimmed) == 0:
+		return nil, errors.New("processing report is empty")
+	case trimmed[0] == '{':
+		return parseJSONFeedProcessingReport(trimmed)
+	case trimmed[0] == '<':
+		return parseXMLFeedProcessingReport(trimmed)
+	default:
+		return parseFlatFileFeedProcessingReport(content)
+	}
+}
+
+func parseJSONFeedProcessingReport(content []byte) (*feedProcessingReport, error) {
+	var listingsReport listingsFeedReport
+	if err := json.Unmarshal(content, &listingsReport); err != nil {
+		return nil, fmt.Errorf("decoding JSON processing report: %w", err)
+	}
+
+	report := &feedProcessingReport{
+		MessagesProcessed: listingsReport.Summary.MessagesProcessed,
+		MessagesAccepted:  listingsReport.Summary.MessagesAccepted,
+		Errors:            listingsReport.Summary.Errors,
+		Warnings:          listingsReport.Summary.Warnings,
+		Issues:            []feedProcessingIssue{},
+	}
+
+	for _, issue := range listingsReport.Issues {
+		report.Issues = append(report.Issues, feedProcessingIssue{
+			MessageID: issue.MessageID,
+			Severity:  issue.Severity,
+			Code:      issue.Code,
+			Message:   issue.Message,
+		})
+	}
+
+	return report, nil
+}
+
+// xmlProcessingReport is the ProcessingReport message of an AmazonEnvelope.
+type xmlProcessingReport struct {
+	StatusCode        string `xml:"StatusCode"`
+	ProcessingSummary struct {
+		MessagesProcessed   int64 `xml:"MessagesProcessed"`
+		MessagesSuccessful  int64 `xml:"MessagesSuccessful"`
+		MessagesWithError   int64 `xml:"MessagesWithError"`
+		MessagesWithWarning int64 `xml:"MessagesWithWarning"`
+	} `xml:"ProcessingSummary"`
+	Results []struct {
+		MessageID         int64  `xml:"MessageID"`
+		ResultCode        string `xml:"ResultCode"`
+		ResultMessageCode string `xml:"ResultMessageCode"`
+		ResultDescription string `xml:"ResultDescription"`
+		AdditionalInfo    struct {
+			SKU string `xml:"SKU"`
+		} `xml:"AdditionalInfo"`
+	} `xml:"Result"`
+}
+
+type xmlProcessingReportEnvelope struct {
+	XMLName  xml.Name `xml:"AmazonEnvelope"`
+	Messages []struct {
+		ProcessingReport *xmlProcessingReport `xml:"ProcessingReport"`
+	} `xml:"Message"`
+}
+
+func parseXMLFeedProcessingReport(content []byte) (*feedProcessingReport, error) {
+	var envelope xmlProcessingReportEnvelope
+	if err := xml.Unmarshal(content, &envelope); err != nil {
+		return nil, fmt.Errorf("decoding XML processing report: %w", err)
+	}
+
+	report := &feedProcessingReport{Issues: []feedProcessingIssue{}}
+	found := false
+
+	for _, message := range envelope.Messages {
+		processingReport := message.ProcessingReport
+		if processingReport == nil {
+			continue
+		}
+
+		found = true
+
+		report.MessagesProcessed += processingReport.ProcessingSummary.MessagesProcessed
+		report.MessagesAccepted += processingReport.ProcessingSummary.MessagesSuccessful
+		report.Errors += processingReport.ProcessingSummary.MessagesWithError
+		report.Warnings += processingReport.ProcessingSummary.MessagesWithWarning
+
+		for _, result := range processingReport.Results {
+			report.Issues = append(report.Issues, feedProcessingIssue{
+				MessageID: result.MessageID,
+				SKU:       result.AdditionalInfo.SKU,
+				Severity:  strings.ToUpper(result.ResultCode),
+				Code:      result.ResultMessageCode,
+				Message:   strings.TrimSpace(result.ResultDescription),
+			})
+		}
+	}
+
+	if !found {
+		return nil, errors.New("XML document has no ProcessingReport message")
+	}
+
+	return report, nil
+}
+
+// parseFlatFileFeedProcessingReport parses the tab-separated report of flat
+// file feeds. It starts with a summary of the number of records processed
+// and successful, followed by a table with a row per error or warning.
+func parseFlatFileFeedProcessingReport(content []byte) (*feedProcessingReport, error) {
+	report := &feedProcessingReport{Issues: []feedProcessingIssue{}}
+
+	var columns map[string]int
+	processed, successful := false, false
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+
+		if columns == nil {
+			label := strings.ToLower(strings.TrimSpace(line))
+
+			switch {
+			case strings.HasPrefix(label, "number of records processed"):
+				report.MessagesProcessed, processed = lastFlatFileNumber(fields), true
+			case strings.HasPrefix(label, "number of records successful"):
+				report.MessagesAccepted, successful = lastFlatFileNumber(fields), true
+			case strings.Contains(label, "error-code") || strings.Contains(label, "error-message"):
+				columns = make(map[string]int, len(fields))
+				for i, name := range fields {
+					columns[strings.ToLower(strings.TrimSpace(name))] = i
+				}
+			}
+
+			continue
+		}
+
+		column := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(fields) {
+				return strings.TrimSpace(fields[i])
+			}
+
+			return ""
+		}
+
+		issue := feedProcessingIssue{
+			SKU:      column("sku"),
+			Severity: strings.ToUpper(column("error-type")),
+			Code:     column("error-code"),
+			Message:  column("error-message"),
+		}
+
+		if issue.Severity == "" {
+			issue.Severity = "ERROR"
+		}
+
+		if messageID, err := strconv.ParseInt(column("original-record-number"), 10, 64); err == nil {
+			issue.MessageID = messageID
+		}
+
+		switch issue.Severity {
+		case "ERROR":
+			report.Errors++
+		case "WARNING":
+			report.Warnings++
+		}
+
+		report.Issues = append(report.Issues, issue)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading processing report: %w", err)
+	}
+
+	if !processed && !successful && columns == nil {
+		return nil, errors.New("unrecognized processing report format")
+	}
+
+	return report, nil
+}
+
+// lastFlatFileNumber returns the last numeric field of a summary line, which
+// is padded with empty fields.
+func lastFlatFileNumber(fields []string) int64 {
+	for i := len(fields) - 1; i >= 0; i-- {
+		if n, err := strconv.ParseInt(strings.TrimSpace(fields[i]), 10, 64); err == nil {
+			return n
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestParseFeedProcessingReport(t *testing.T) {
+	want := []feedProcessingIssue{
+		{MessageID: 2, SKU: "SKU-2", Severity: "ERROR", Code: "8560", Message: "SKU SKU-2, Missing Attributes standard_product_id."},
+		{MessageID: 3, SKU: "SKU-3", Severity: "WARNING", Code: "99001", Message: "A value is missing for the item_weight field."},
+	}
+
+	tests := map[string]struct {
+		content []byte
+		want    feedProcessingReport
+	}{
+		"json": {
+			content: []byte(`{
+				"header": {"sellerId": "A1EXAMPLE", "version": "2.0", "feedId": "50012345678"},
+				"issues": [
+					{"messageId": 2, "code": "90220", "severity": "ERROR", "message": "'quantity' is required but not supplied."}
+				],
+				"summary": {"errors": 1, "warnings": 0, "messagesProcessed": 2, "messagesAccepted": 1, "messagesInvalid": 1}
+			}`),
+			want: feedProcessingReport{
+				MessagesProcessed: 2,
+				MessagesAccepted:  1,
+				Errors:            1,
+				Issues: []feedProcessingIssue{
+					{MessageID: 2, Severity: "ERROR", Code: "90220", Message: "'quantity' is required but not supplied."},
+				},
+			},
+		},
+		"xml": {
+			content: readTestdata(t, "feed_processing_report.xml"),
+			want: feedProcessingReport{
+				MessagesProcessed: 3,
+				MessagesAccepted:  1,
+				Errors:            1,
+				Warnings:          1,
+				Issues:            want,
+			},
+		},
+		"tsv": {
+			content: readTestdata(t, "feed_processing_report.tsv"),
+			want: feedProcessingReport{
+				MessagesProcessed: 3,
+				MessagesAccepted:  2,
+				Errors:            1,
+				Warnings:          1,
+				Issues: []feedProcessingIssue{
+					{MessageID: 2, SKU: "SKU-2", Severity: "ERROR", Code: "90220", Message: "quantity is a required field."},
+					{MessageID: 3, SKU: "SKU-3", Severity: "WARNING", Code: "99010", Message: "A value is missing for the price field."},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			report, err := parseFeedProcessingReport(test.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(*report, test.want) {
+				t.Errorf("got %+v, want %+v", *report, test.want)
+			}
+		})
+	}
+}
+
+func TestParseFeedProcessingReportUnrecognized(t *testing.T) {
+	for _, content := range []string{"", "not a report\n", `<AmazonEnvelope><MessageType>Inventory</MessageType></AmazonEnvelope>`} {
+		if _, err := parseFeedProcessingReport([]byte(content)); err == nil {
+			t.Errorf("parsing %q succeeded, want an error", content)
+		}
+	}
+}
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+
+	content, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return content
+}
+
+func TestFeedProcessingReportDiagnostics(t *testing.T) {
+	diags := feedProcessingReportDiagnostics("50001", &feedProcessingReport{
+		Errors:   1,
+		Warnings: 1,
+		Issues: []feedProcessingIssue{
+			{MessageID: 2, SKU: "SKU-2", Severity: "ERROR", Code: "8560", Message: "Missing Attributes standard_product_id."},
+			{MessageID: 3, SKU: "SKU-3", Severity: "WARNING", Code: "99001", Message: "A value is missing for the item_weight field."},
+		},
+	})
+
+	if diags.HasError() {
+		t.Errorf("got error diagnostics for processing report issues: %v", diags.Errors())
+	}
+
+	if diags.WarningsCount() != 2 {
+		t.Errorf("got %d warnings, want one per issue: %v", diags.WarningsCount(), diags.Warnings())
+	}
+}
@@ -0,0 +1,552 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"slices"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &feedResource{}
+	_ resource.ResourceWithConfigure      = &feedResource{}
+	_ resource.ResourceWithModifyPlan     = &feedResource{}
+	_ resource.ResourceWithValidateConfig = &feedResource{}
+)
+
+// NewFeedResource is a helper function to simplify the provider implementation.
+func NewFeedResource() resource.Resource {
+	return &feedResource{}
+}
+
+// feedResource is the resource implementation.
+type feedResource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+// Metadata returns the resource type name.
+func (r *feedResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feed"
+}
+
+func (r *feedResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.sellingPartner = providerData.SellingPartner
+	r.region = providerData.Region
+}
+
+// Schema defines the schema for the resource.
+func (r *feedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Submits a feed with the Feeds API and waits until it is processed. A feed cannot be changed " +
+			"once submitted, so a change of its content submits a new feed. A feed that fails or is still being " +
+			"processed after processing_timeout is kept with a warning, so it is not submitted again. Errors in the " +
+			"processing report are warnings too, as they are listed in processing_issues and error_count.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"feed_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Feed type, e.g. JSON_LISTINGS_FEED or POST_FLAT_FILE_INVLOADER_DATA.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Feed content. Conflicts with content_file.",
+			},
+			"content_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file with the feed content. Conflicts with content.",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the feed content. A new feed is submitted when it changes.",
+			},
+			"content_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Content type of the feed, e.g. application/json or text/tab-separated-values; charset=UTF-8.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"feed_options": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional options of the feed type.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"processing_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("30m"),
+				Description: "How long to wait for the feed to be processed, as a Go duration.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"fail_on_processing_error": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Fail with an error when the feed finishes with a status other than DONE. The resource is then tainted and the feed submitted again by the next apply.",
+			},
+			"processing_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"processing_start_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"processing_end_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"result_feed_document_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"processing_report": schema.StringAttribute{
+				Computed:    true,
+				Description: "Decompressed content of the processing report.",
+			},
+			"messages_processed": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of messages, or records, processed according to the processing report.",
+			},
+			"messages_accepted": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of messages, or records, processed successfully according to the processing report.",
+			},
+			"error_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of errors in the processing report.",
+			},
+			"warning_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of warnings in the processing report.",
+			},
+			"processing_issues": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Errors and warnings of the processing report.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"message_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Message ID or record number the issue is about. Unset for issues about the whole feed.",
+						},
+						"sku": schema.StringAttribute{
+							Computed: true,
+						},
+						"severity": schema.StringAttribute{
+							Computed:    true,
+							Description: "ERROR or WARNING.",
+						},
+						"code": schema.StringAttribute{
+							Computed: true,
+						},
+						"message": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *feedResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var content, contentFile types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_file"), &contentFile)...)
+	if resp.Diagnostics.HasError() || content.IsUnknown() || contentFile.IsUnknown() {
+		return
+	}
+
+	if content.IsNull() == contentFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid feed content",
+			"Exactly one of content and content_file must be set.",
+		)
+	}
+}
+
+// ModifyPlan hashes the planned content so that a change of the content, or
+// of the file it is read from, submits a new feed. The path of content_file
+// alone does not matter.
+func (r *feedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var content, contentFile types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("content"), &content)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("content_file"), &contentFile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentHash := types.StringUnknown()
+
+	if !content.IsUnknown() && !contentFile.IsUnknown() {
+		hash, err := feedContentHash(content, contentFile)
+
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// The file may be written by another resource during apply.
+		case err != nil:
+			resp.Diagnostics.AddAttributeError(path.Root("content_file"), "Error reading feed content", err.Error())
+			return
+		default:
+			contentHash = types.StringValue(hash)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), contentHash)...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var priorHash types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("content_hash"), &priorHash)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !contentHash.Equal(priorHash) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+		return
+	}
+
+	// The feed is unchanged, so its processing details are too.
+	for _, name := range []string{"processing_status", "processing_start_time", "processing_end_time", "result_feed_document_id", "processing_report"} {
+		var value types.String
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &value)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	for _, name := range []string{"messages_processed", "messages_accepted", "error_count", "warning_count"} {
+		var value types.Int64
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &value)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	var issues types.List
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("processing_issues"), &issues)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("processing_issues"), issues)...)
+}
+
+// feedContent returns the inline content or the content of the file.
+func feedContent(content types.String, contentFile types.String) ([]byte, error) {
+	if !content.IsNull() {
+		return []byte(content.ValueString()), nil
+	}
+
+	return os.ReadFile(contentFile.ValueString())
+}
+
+func feedContentHash(content types.String, contentFile types.String) (string, error) {
+	data, err := feedContent(content, contentFile)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// feedProcessingIssueDiagnosticsLimit is the number of processing report
+// issues reported as diagnostics, so a large feed does not flood the output.
+const feedProcessingIssueDiagnosticsLimit = 25
+
+// feedProcessingReportDiagnostics reports the issues of a processing report
+// as warning diagnostics, since the feed has been processed and its errors are
+// kept in processing_issues and error_count. Only the first issues are
+// reported.
+func feedProcessingReportDiagnostics(feedID string, report *feedProcessingReport) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, issue := range report.Issues {
+		if i == feedProcessingIssueDiagnosticsLimit {
+			diags.AddWarning(
+				"More feed processing issues",
+				fmt.Sprintf("Feed %s has %d more issues. See processing_issues for all of them.", feedID, len(report.Issues)-i),
+			)
+			break
+		}
+
+		subject := fmt.Sprintf("Feed %s", feedID)
+		if issue.MessageID != 0 {
+			subject += fmt.Sprintf(" message %d", issue.MessageID)
+		}
+		if issue.SKU != "" {
+			subject += fmt.Sprintf(" (SKU %s)", issue.SKU)
+		}
+
+		summary := "Feed processing warning"
+		if issue.Severity == "ERROR" || issue.Severity == "FATAL" {
+			summary = "Feed processing error"
+		}
+
+		diags.AddWarning(summary, fmt.Sprintf("%s: %s: %s", subject, issue.Code, issue.Message))
+	}
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *feedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan feedModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := feedContent(plan.Content, plan.ContentFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content_file"), "Error reading feed content", err.Error())
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	plan.ContentHash = types.StringValue(hex.EncodeToString(sum[:]))
+
+	client := newFeedsClient(r.sellingPartner, r.region)
+
+	feedID, err := submitFeed(ctx, client, plan.FeedType.ValueString(), plan.marketplaceIDs(), plan.ContentType.ValueString(), content, plan.feedOptions())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating feed", err.Error())
+		return
+	}
+
+	// Save the feed right away, so it is not lost if processing times out.
+	plan.ID = types.StringValue(feedID)
+	plan.ProcessingStatus = types.StringValue("IN_QUEUE")
+	plan.CreatedTime = types.StringNull()
+	plan.ProcessingStartTime = types.StringNull()
+	plan.ProcessingEndTime = types.StringNull()
+	plan.ResultFeedDocumentID = types.StringNull()
+	plan.clearProcessingReport()
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The feed has been submitted, so errors from here on are warnings: an
+	// error would taint the resource and submit the feed a second time. Read
+	// picks up the outcome of a feed that is still being processed.
+	feed, err := waitForFeed(ctx, client, feedID, plan.ProcessingTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Feed processing not finished",
+			fmt.Sprintf("%s. The feed is kept and its outcome is read on the next refresh.", err),
+		)
+		return
+	}
+
+	plan.setFeed(feed)
+
+	if feed.ResultFeedDocumentID != nil {
+		content, err := getFeedDocument(ctx, client, *feed.ResultFeedDocumentID)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Error getting feed processing report", err.Error())
+		} else if report, err := plan.setProcessingReport(content); err != nil {
+			resp.Diagnostics.AddWarning("Error parsing feed processing report", err.Error())
+		} else {
+			resp.Diagnostics.Append(feedProcessingReportDiagnostics(feedID, report)...)
+		}
+	}
+
+	if feed.ProcessingStatus != "DONE" {
+		summary := "Feed processing failed"
+		detail := fmt.Sprintf("Feed %s finished with status %s. See processing_report for details.", feedID, feed.ProcessingStatus)
+
+		if plan.FailOnProcessingError.ValueBool() {
+			resp.Diagnostics.AddError(summary, detail)
+		} else {
+			resp.Diagnostics.AddWarning(summary, detail)
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *feedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state feedModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newFeedsClient(r.sellingPartner, r.region)
+
+	feed, err := client.GetFeed(ctx, state.ID.ValueString())
+	if isSPAPINotFound(err) {
+		// Amazon drops feeds some time after they are processed. A processed
+		// feed does not change anymore, so its state is kept instead of
+		// submitting the feed again.
+		if slices.Contains(feedProcessingDoneStatuses, state.ProcessingStatus.ValueString()) {
+			resp.Diagnostics.AddWarning(
+				"Feed no longer available",
+				fmt.Sprintf("Feed %s was processed and is no longer returned by getFeed; its state is kept as is.", state.ID.ValueString()),
+			)
+			return
+		}
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting feed", err.Error())
+		return
+	}
+
+	state.setFeed(feed)
+
+	// The report of a feed that finished after a timed out apply is fetched once.
+	if feed.ResultFeedDocumentID != nil && state.ProcessingReport.IsNull() {
+		content, err := getFeedDocument(ctx, client, *feed.ResultFeedDocumentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting feed processing report", err.Error())
+			return
+		}
+
+		if _, err := state.setProcessingReport(content); err != nil {
+			resp.Diagnostics.AddWarning("Error parsing feed processing report", err.Error())
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *feedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state feedModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Any change to the feed itself requires a replacement, so only the way
+	// the content is supplied, the timeout and fail_on_processing_error can
+	// change in place.
+	state.Content = plan.Content
+	state.ContentFile = plan.ContentFile
+	state.ProcessingTimeout = plan.ProcessingTimeout
+	state.FailOnProcessingError = plan.FailOnProcessingError
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete cancels the feed if it is still queued and removes the Terraform
+// state. Processed feeds cannot be undone.
+func (r *feedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state feedModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if slices.Contains(feedProcessingDoneStatuses, state.ProcessingStatus.ValueString()) {
+		return
+	}
+
+	client := newFeedsClient(r.sellingPartner, r.region)
+
+	// Only feeds that are still IN_QUEUE can be cancelled, anything else is
+	// already being processed and is left to finish.
+	err := client.CancelFeed(ctx, state.ID.ValueString())
+	if respErr, ok := err.(*spapiResponseError); ok && (respErr.StatusCode == http.StatusBadRequest || respErr.StatusCode == http.StatusNotFound) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error cancelling feed", err.Error())
+		return
+	}
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// feedProcessingDoneStatuses are the processingStatus values of a feed that
+// will not change anymore.
+var feedProcessingDoneStatuses = []string{"DONE", "FATAL", "CANCELLED"}
+
+// feedsClient calls the Feeds API (version 2021-06-30). The SDK only
+// generates a client for the retired 2020-09-04 version.
+type feedsClient struct {
+	sellingPartner *sp.SellingPartner
+	endpoint       string
+}
+
+func newFeedsClient(sellingPartner *sp.SellingPartner, region spapiRegion) *feedsClient {
+	return &feedsClient{
+		sellingPartner: sellingPartner,
+		endpoint:       region.Endpoint,
+	}
+}
+
+type feed struct {
+	FeedID               string     `json:"feedId"`
+	FeedType             string     `json:"feedType"`
+	MarketplaceIDs       []string   `json:"marketplaceIds"`
+	CreatedTime          time.Time  `json:"createdTime"`
+	ProcessingStatus     string     `json:"processingStatus"`
+	ProcessingStartTime  *time.Time `json:"processingStartTime"`
+	ProcessingEndTime    *time.Time `json:"processingEndTime"`
+	ResultFeedDocumentID *string    `json:"resultFeedDocumentId"`
+}
+
+type feedSpecification struct {
+	FeedType            string            `json:"feedType"`
+	MarketplaceIDs      []string          `json:"marketplaceIds"`
+	InputFeedDocumentID string            `json:"inputFeedDocumentId"`
+	FeedOptions         map[string]string `json:"feedOptions,omitempty"`
+}
+
+type feedDocument struct {
+	FeedDocumentID       string `json:"feedDocumentId"`
+	URL                  string `json:"url"`
+	CompressionAlgorithm string `json:"compressionAlgorithm"`
+}
+
+// CreateFeedDocument creates a feed document, returning its ID and the URL
+// to upload the content to.
+func (c *feedsClient) CreateFeedDocument(ctx context.Context, contentType string) (*feedDocument, error) {
+	body := map[string]string{"contentType": contentType}
+
+	var document feedDocument
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodPost, c.endpoint+"/feeds/2021-06-30/documents", nil, body, &document); err != nil {
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+// CreateFeed creates a feed for an uploaded feed document, returning the
+// feed ID.
+func (c *feedsClient) CreateFeed(ctx context.Context, specification feedSpecification) (string, error) {
+	var result struct {
+		FeedID string `json:"feedId"`
+	}
+
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodPost, c.endpoint+"/feeds/2021-06-30/feeds", nil, specification, &result); err != nil {
+		return "", err
+	}
+
+	return result.FeedID, nil
+}
+
+// GetFeed returns a feed, or an spapiResponseError when it does not exist.
+func (c *feedsClient) GetFeed(ctx context.Context, feedID string) (*feed, error) {
+	var result feed
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/feeds/2021-06-30/feeds/"+url.PathEscape(feedID), nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelFeed cancels a feed that is still IN_QUEUE.
+func (c *feedsClient) CancelFeed(ctx context.Context, feedID string) error {
+	return doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodDelete, c.endpoint+"/feeds/2021-06-30/feeds/"+url.PathEscape(feedID), nil, nil, nil)
+}
+
+// GetFeedDocument returns where a feed document can be downloaded from and
+// how it is compressed.
+func (c *feedsClient) GetFeedDocument(ctx context.Context, documentID string) (*feedDocument, error) {
+	var document feedDocument
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/feeds/2021-06-30/documents/"+url.PathEscape(documentID), nil, nil, &document); err != nil {
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+// submitFeed uploads the feed content to a new feed document and creates a
+// feed for it, returning the feed ID.
+func submitFeed(ctx context.Context, client *feedsClient, feedType string, marketplaceIDs []string, contentType string, content []byte, options map[string]string) (string, error) {
+	document, err := client.CreateFeedDocument(ctx, contentType)
+	if err != nil {
+		return "", fmt.Errorf("creating feed document: %w", err)
+	}
+
+	if err := uploadDocument(ctx, document.URL, contentType, content); err != nil {
+		return "", err
+	}
+
+	feedID, err := client.CreateFeed(ctx, feedSpecification{
+		FeedType:            feedType,
+		MarketplaceIDs:      marketplaceIDs,
+		InputFeedDocumentID: document.FeedDocumentID,
+		FeedOptions:         options,
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating feed: %w", err)
+	}
+
+	return feedID, nil
+}
+
+// waitForFeed polls GetFeed until the feed is done processing or the timeout
+// expires.
+func waitForFeed(ctx context.Context, client *feedsClient, feedID string, timeout string) (*feed, error) {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid processing_timeout %q: %w", timeout, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	// getFeed allows about one request every 30 seconds after a short burst.
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		feed, err := client.GetFeed(ctx, feedID)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(feedProcessingDoneStatuses, feed.ProcessingStatus) {
+			return feed, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for feed processing", map[string]interface{}{"feed_id": feedID, "processing_status": feed.ProcessingStatus})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("feed %s did not finish processing within %s", feedID, duration)
+		case <-ticker.C:
+		}
+	}
+}
+
+// getFeedDocument downloads and decompresses a feed document such as the
+// processing report of a feed.
+func getFeedDocument(ctx context.Context, client *feedsClient, documentID string) ([]byte, error) {
+	document, err := client.GetFeedDocument(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("getting feed document: %w", err)
+	}
+
+	return fetchDocument(ctx, document.URL, document.CompressionAlgorithm)
+}
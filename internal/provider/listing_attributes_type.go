@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// listingAttributeDefaults are the values Amazon fills in for the
+// marketplace_id and language_tag keys when listing attribute values omit
+// them: the marketplaces of the listing and their default languages. A key
+// missing on one side is not a difference when the other side holds one of
+// these defaults. Without defaults every missing key is a difference.
+type listingAttributeDefaults map[string][]string
+
+func newListingAttributeDefaults(marketplaceIDs []string) listingAttributeDefaults {
+	defaults := listingAttributeDefaults{}
+
+	for _, id := range marketplaceIDs {
+		defaults["marketplace_id"] = append(defaults["marketplace_id"], id)
+
+		if m, ok := lookupMarketplaceByID(id); ok {
+			defaults["language_tag"] = append(defaults["language_tag"], m.DefaultLanguageCode)
+		}
+	}
+
+	return defaults
+}
+
+// holds reports whether value is a default of key.
+func (d listingAttributeDefaults) holds(key string, value interface{}) bool {
+	s, ok := value.(string)
+
+	return ok && slices.Contains(d[key], s)
+}
+
+var (
+	_ basetypes.StringTypable                    = listingAttributesType{}
+	_ xattr.TypeWithValidate                     = listingAttributesType{}
+	_ basetypes.StringValuableWithSemanticEquals = listingAttributesValue{}
+)
+
+// listingAttributesType is a string type holding SP-API listing attributes as
+// a JSON object. Its values compare semantically, so the form Amazon returns
+// attributes in does not show up as drift against the configured JSON.
+type listingAttributesType struct {
+	basetypes.StringType
+}
+
+func (t listingAttributesType) String() string {
+	return "listingAttributesType"
+}
+
+func (t listingAttributesType) ValueType(ctx context.Context) attr.Value {
+	return listingAttributesValue{}
+}
+
+func (t listingAttributesType) Equal(o attr.Type) bool {
+	other, ok := o.(listingAttributesType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t listingAttributesType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return listingAttributesValue{StringValue: in}, nil
+}
+
+func (t listingAttributesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// Validate checks that configured values are JSON objects.
+func (t listingAttributesType) Validate(ctx context.Context, in tftypes.Value, attrPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(attrPath, "Invalid listing attributes", err.Error())
+		return diags
+	}
+
+	if _, err := decodeListingAttributes(value); err != nil {
+		diags.AddAttributeError(attrPath, "Invalid listing attributes", err.Error())
+	}
+
+	return diags
+}
+
+// listingAttributesValue is the value of listingAttributesType.
+type listingAttributesValue struct {
+	basetypes.StringValue
+}
+
+func newListingAttributesValue(value string) listingAttributesValue {
+	return listingAttributesValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v listingAttributesValue) Type(ctx context.Context) attr.Type {
+	return listingAttributesType{}
+}
+
+func (v listingAttributesValue) Equal(o attr.Value) bool {
+	other, ok := o.(listingAttributesValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v listingAttributesValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(listingAttributesValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Values do not know the marketplaces of their listing, so keys filled
+	// in with marketplace defaults are compared where the listing is known.
+	return listingAttributesJSONEqual(v.ValueString(), newValue.ValueString(), nil), diags
+}
+
+// listingAttributesJSONEqual reports whether two listing attribute documents
+// describe the same listing. Besides key order and whitespace it ignores the
+// order of values within an attribute, the formatting of numbers and keys
+// Amazon fills in with the defaults of the listing.
+func listingAttributesJSONEqual(a string, b string, defaults listingAttributeDefaults) bool {
+	va, err := decodeListingJSON([]byte(a))
+	if err != nil {
+		return false
+	}
+
+	vb, err := decodeListingJSON([]byte(b))
+	if err != nil {
+		return false
+	}
+
+	return listingJSONValuesEqual(va, vb, defaults)
+}
+
+// listingAttributeValuesEqual is listingAttributesJSONEqual for the value of a
+// single attribute.
+func listingAttributeValuesEqual(a json.RawMessage, b json.RawMessage, defaults listingAttributeDefaults) bool {
+	return listingAttributesJSONEqual(string(a), string(b), defaults)
+}
+
+func decodeListingJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func listingJSONValuesEqual(a interface{}, b interface{}, defaults listingAttributeDefaults) bool {
+	switch va := a.(type) {
+	case map[string]interface{}:
+		vb, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for key, value := range va {
+			other, ok := vb[key]
+			if !ok {
+				if defaults.holds(key, value) {
+					continue
+				}
+				return false
+			}
+
+			if !listingJSONValuesEqual(value, other, defaults) {
+				return false
+			}
+		}
+
+		for key, value := range vb {
+			if _, ok := va[key]; !ok && !defaults.holds(key, value) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		vb, ok := b.([]interface{})
+		if !ok || len(va) != len(vb) {
+			return false
+		}
+
+		// Amazon does not preserve the order of attribute values, so the
+		// elements are matched as a multiset.
+		matched := make([]bool, len(vb))
+		for _, elem := range va {
+			found := false
+
+			for i, other := range vb {
+				if !matched[i] && listingJSONValuesEqual(elem, other, defaults) {
+					matched[i] = true
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return false
+			}
+		}
+
+		return true
+	case json.Number:
+		vb, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+
+		ra, okA := new(big.Rat).SetString(va.String())
+		rb, okB := new(big.Rat).SetString(vb.String())
+		if !okA || !okB {
+			return va == vb
+		}
+
+		return ra.Cmp(rb) == 0
+	default:
+		return a == b
+	}
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListingAttributesJSONEqual(t *testing.T) {
+	tests := []struct {
+		name           string
+		marketplaceIDs []string
+		a, b           string
+		equal          bool
+	}{
+		{
+			name:  "key order and whitespace",
+			a:     `{"item_name":[{"value":"Mug","language_tag":"en_US"}]}`,
+			b:     `{ "item_name": [ { "language_tag": "en_US", "value": "Mug" } ] }`,
+			equal: true,
+		},
+		{
+			name:  "marketplace defaults added by Amazon",
+			a:     `{"item_name":[{"value":"Mug"}]}`,
+			b:     `{"item_name":[{"value":"Mug","language_tag":"en_US","marketplace_id":"ATVPDKIKX0DER"}]}`,
+			equal: true,
+		},
+		{
+			name:           "default language removed on a UK listing",
+			marketplaceIDs: []string{"A1F83G8C2ARO7P"},
+			a:              `{"item_name":[{"value":"Mug","language_tag":"en_GB"}]}`,
+			b:              `{"item_name":[{"value":"Mug"}]}`,
+			equal:          true,
+		},
+		{
+			name:           "other language removed on a UK listing",
+			marketplaceIDs: []string{"A1F83G8C2ARO7P"},
+			a:              `{"item_name":[{"value":"Mug","language_tag":"de_DE"}]}`,
+			b:              `{"item_name":[{"value":"Mug"}]}`,
+			equal:          false,
+		},
+		{
+			name:           "other marketplace removed on a UK listing",
+			marketplaceIDs: []string{"A1F83G8C2ARO7P"},
+			a:              `{"item_name":[{"value":"Mug"}]}`,
+			b:              `{"item_name":[{"value":"Mug","marketplace_id":"A1PA6795UKMFR9"}]}`,
+			equal:          false,
+		},
+		{
+			name:  "reordered values",
+			a:     `{"bullet_point":[{"value":"A"},{"value":"B"}]}`,
+			b:     `{"bullet_point":[{"value":"B"},{"value":"A"}]}`,
+			equal: true,
+		},
+		{
+			name:  "numeric formatting",
+			a:     `{"list_price":[{"value":10,"currency":"USD"}]}`,
+			b:     `{"list_price":[{"value":10.00,"currency":"USD"}]}`,
+			equal: true,
+		},
+		{
+			name:  "different language tag",
+			a:     `{"item_name":[{"value":"Mug","language_tag":"en_US"}]}`,
+			b:     `{"item_name":[{"value":"Mug","language_tag":"en_GB"}]}`,
+			equal: false,
+		},
+		{
+			name:  "different number",
+			a:     `{"list_price":[{"value":10}]}`,
+			b:     `{"list_price":[{"value":10.5}]}`,
+			equal: false,
+		},
+		{
+			name:  "extra attribute",
+			a:     `{"color":[{"value":"Red"}]}`,
+			b:     `{"color":[{"value":"Red"}],"size":[{"value":"L"}]}`,
+			equal: false,
+		},
+		{
+			name:  "duplicate values are not collapsed",
+			a:     `{"bullet_point":[{"value":"A"},{"value":"A"}]}`,
+			b:     `{"bullet_point":[{"value":"A"},{"value":"B"}]}`,
+			equal: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			marketplaceIDs := tt.marketplaceIDs
+			if marketplaceIDs == nil {
+				marketplaceIDs = []string{"ATVPDKIKX0DER"}
+			}
+
+			if got := listingAttributesJSONEqual(tt.a, tt.b, newListingAttributeDefaults(marketplaceIDs)); got != tt.equal {
+				t.Errorf("listingAttributesJSONEqual() = %v, want %v", got, tt.equal)
+			}
+		})
+	}
+}
+
+func TestListingAttributesValueStringSemanticEquals(t *testing.T) {
+	prior := newListingAttributesValue(`{"item_name":[{"value":"Mug","language_tag":"en_US"}]}`)
+	refreshed := newListingAttributesValue(`{ "item_name": [ { "language_tag": "en_US", "value": "Mug" } ] }`)
+
+	equal, diags := prior.StringSemanticEquals(context.Background(), refreshed)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !equal {
+		t.Error("expected values to be semantically equal")
+	}
+
+	// Without the marketplace of the listing, a filled in language is a change.
+	defaulted := newListingAttributesValue(`{"item_name":[{"value":"Mug"}]}`)
+
+	if equal, _ := defaulted.StringSemanticEquals(context.Background(), refreshed); equal {
+		t.Error("expected a missing language tag to be a difference without the marketplace")
+	}
+}
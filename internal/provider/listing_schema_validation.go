@@ -0,0 +1,597 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// listingSchemaViolation is a single reason listing attributes do not conform
+// to a product type schema. Path is a JSON pointer into the attributes.
+type listingSchemaViolation struct {
+	Path    string
+	Message string
+}
+
+// listingSchemaResult collects the outcome of validating listing attributes
+// against a product type schema. NonEditable and Hidden hold the paths of
+// supplied values whose schema carries Amazon's editable: false or
+// hidden: true annotations, which cannot be enforced without the live listing.
+type listingSchemaResult struct {
+	Violations  []listingSchemaViolation
+	NonEditable []string
+	Hidden      []string
+}
+
+// listingSchemaValidator validates listing attributes against the JSON schema
+// returned by getDefinitionsProductType. It implements the subset of JSON
+// Schema 2019-09 that product type schemas use, plus Amazon's selectors,
+// minUniqueItems and maxUniqueItems vocabulary. Only local $ref pointers are
+// resolved, any other $ref is reported as a violation.
+type listingSchemaValidator struct {
+	root     any
+	patterns map[string]*regexp.Regexp
+
+	// applying holds the schemas being applied to each value path, so a $ref
+	// cycle such as {"$ref": "#"} does not recurse forever.
+	applying map[listingSchemaApplication]bool
+}
+
+type listingSchemaApplication struct {
+	schema uintptr
+	path   string
+}
+
+// validateListingSchema validates the listing attributes JSON object against a
+// product type schema.
+func validateListingSchema(schemaJSON []byte, attributesJSON []byte) (*listingSchemaResult, error) {
+	schema, err := decodeJSONNumbers(schemaJSON)
+	if err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %w", err)
+	}
+
+	attributes, err := decodeJSONNumbers(attributesJSON)
+	if err != nil {
+		return nil, fmt.Errorf("attributes are not valid JSON: %w", err)
+	}
+
+	v := &listingSchemaValidator{
+		root:     schema,
+		patterns: map[string]*regexp.Regexp{},
+		applying: map[listingSchemaApplication]bool{},
+	}
+
+	result := &listingSchemaResult{}
+	v.validate(schema, attributes, "", result)
+
+	result.NonEditable = sortedUnique(result.NonEditable)
+	result.Hidden = sortedUnique(result.Hidden)
+
+	return result, nil
+}
+
+func decodeJSONNumbers(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func sortedUnique(values []string) []string {
+	sort.Strings(values)
+
+	unique := []string{}
+	for i, value := range values {
+		if i == 0 || value != values[i-1] {
+			unique = append(unique, value)
+		}
+	}
+
+	return unique
+}
+
+func (r *listingSchemaResult) addf(path string, format string, args ...any) {
+	r.Violations = append(r.Violations, listingSchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// matches reports whether value validates against schema, discarding the details.
+func (v *listingSchemaValidator) matches(schema any, value any, path string) bool {
+	result := &listingSchemaResult{}
+	v.validate(schema, value, path, result)
+
+	return len(result.Violations) == 0
+}
+
+func (v *listingSchemaValidator) validate(schema any, value any, path string, result *listingSchemaResult) {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			result.addf(path, "no value is allowed")
+		}
+		return
+	case map[string]any:
+		v.validateObjectSchema(s, value, path, result)
+	}
+}
+
+func (v *listingSchemaValidator) validateObjectSchema(s map[string]any, value any, path string, result *listingSchemaResult) {
+	// Applying a schema again to the value it is already being applied to adds
+	// nothing. Recursive schemas for nested values end with the value itself.
+	application := listingSchemaApplication{schema: reflect.ValueOf(s).Pointer(), path: path}
+	if v.applying[application] {
+		return
+	}
+
+	v.applying[application] = true
+	defer delete(v.applying, application)
+
+	if ref, ok := s["$ref"].(string); ok {
+		if target, ok := v.resolve(ref); ok {
+			v.validate(target, value, path, result)
+		} else {
+			result.addf(path, "schema reference %s cannot be resolved", ref)
+		}
+	}
+
+	if editable, ok := s["editable"].(bool); ok && !editable {
+		result.NonEditable = append(result.NonEditable, path)
+	}
+
+	if hidden, ok := s["hidden"].(bool); ok && hidden {
+		result.Hidden = append(result.Hidden, path)
+	}
+
+	if t, ok := s["type"]; ok && !matchesJSONType(t, value) {
+		result.addf(path, "expected %s, got %s", describeJSONType(t), jsonTypeOf(value))
+		return
+	}
+
+	if enum, ok := s["enum"].([]any); ok {
+		found := false
+		for _, allowed := range enum {
+			if jsonValuesEqual(allowed, value) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result.addf(path, "value is not one of the allowed values")
+		}
+	}
+
+	if constant, ok := s["const"]; ok && !jsonValuesEqual(constant, value) {
+		result.addf(path, "value must be %s", compactJSON(constant))
+	}
+
+	switch typed := value.(type) {
+	case map[string]any:
+		v.validateObject(s, typed, path, result)
+	case []any:
+		v.validateArray(s, typed, path, result)
+	case string:
+		v.validateString(s, typed, path, result)
+	case json.Number:
+		v.validateNumber(s, typed, path, result)
+	}
+
+	if allOf, ok := s["allOf"].([]any); ok {
+		for _, sub := range allOf {
+			v.validate(sub, value, path, result)
+		}
+	}
+
+	if anyOf, ok := s["anyOf"].([]any); ok {
+		matched := false
+		for _, sub := range anyOf {
+			if v.matches(sub, value, path) {
+				v.validate(sub, value, path, result)
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			result.addf(path, "value does not match any of the allowed schemas")
+		}
+	}
+
+	if oneOf, ok := s["oneOf"].([]any); ok {
+		matched := 0
+		for _, sub := range oneOf {
+			if v.matches(sub, value, path) {
+				matched++
+			}
+		}
+
+		if matched != 1 {
+			result.addf(path, "value must match exactly one schema, matched %d", matched)
+		}
+	}
+
+	if not, ok := s["not"]; ok && v.matches(not, value, path) {
+		result.addf(path, "value matches a schema it must not match")
+	}
+
+	if ifSchema, ok := s["if"]; ok {
+		if v.matches(ifSchema, value, path) {
+			if then, ok := s["then"]; ok {
+				v.validate(then, value, path, result)
+			}
+		} else if elseSchema, ok := s["else"]; ok {
+			v.validate(elseSchema, value, path, result)
+		}
+	}
+}
+
+func (v *listingSchemaValidator) validateObject(s map[string]any, object map[string]any, path string, result *listingSchemaResult) {
+	if required, ok := s["required"].([]any); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, present := object[name]; !present {
+					result.addf(path, "missing required property %q", name)
+				}
+			}
+		}
+	}
+
+	if minProperties, ok := jsonInt(s["minProperties"]); ok && len(object) < minProperties {
+		result.addf(path, "must have at least %d properties", minProperties)
+	}
+
+	if maxProperties, ok := jsonInt(s["maxProperties"]); ok && len(object) > maxProperties {
+		result.addf(path, "must have at most %d properties", maxProperties)
+	}
+
+	properties, _ := s["properties"].(map[string]any)
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertyPath := path + "/" + escapeJSONPointer(name)
+
+		if propertySchema, ok := properties[name]; ok {
+			v.validate(propertySchema, object[name], propertyPath, result)
+			continue
+		}
+
+		if additional, ok := s["additionalProperties"]; ok {
+			if allowed, ok := additional.(bool); ok && !allowed {
+				result.addf(propertyPath, "property %q is not allowed", name)
+				continue
+			}
+
+			v.validate(additional, object[name], propertyPath, result)
+		}
+	}
+}
+
+func (v *listingSchemaValidator) validateArray(s map[string]any, array []any, path string, result *listingSchemaResult) {
+	if minItems, ok := jsonInt(s["minItems"]); ok && len(array) < minItems {
+		result.addf(path, "must have at least %d items", minItems)
+	}
+
+	if maxItems, ok := jsonInt(s["maxItems"]); ok && len(array) > maxItems {
+		result.addf(path, "must have at most %d items", maxItems)
+	}
+
+	if items, ok := s["items"]; ok {
+		for i, item := range array {
+			v.validate(items, item, path+"/"+strconv.Itoa(i), result)
+		}
+	}
+
+	if contains, ok := s["contains"]; ok {
+		count := 0
+		for i, item := range array {
+			if v.matches(contains, item, path+"/"+strconv.Itoa(i)) {
+				count++
+			}
+		}
+
+		minContains, ok := jsonInt(s["minContains"])
+		if !ok {
+			minContains = 1
+		}
+
+		if count < minContains {
+			result.addf(path, "must contain at least %d matching items", minContains)
+		}
+
+		if maxContains, ok := jsonInt(s["maxContains"]); ok && count > maxContains {
+			result.addf(path, "must contain at most %d matching items", maxContains)
+		}
+	}
+
+	if unique, ok := s["uniqueItems"].(bool); ok && unique {
+		for i := range array {
+			for j := i + 1; j < len(array); j++ {
+				if jsonValuesEqual(array[i], array[j]) {
+					result.addf(path, "items %d and %d are equal", i, j)
+				}
+			}
+		}
+	}
+
+	// Amazon's selectors name the properties that identify an item, such as
+	// marketplace_id and language_tag. Items may not share the same selector
+	// values, and minUniqueItems and maxUniqueItems bound the number of
+	// distinct combinations.
+	var selectors []string
+	if values, ok := s["selectors"].([]any); ok {
+		for _, selector := range values {
+			if selector, ok := selector.(string); ok {
+				selectors = append(selectors, selector)
+			}
+		}
+	}
+
+	keys := map[string]int{}
+	for i, item := range array {
+		key := listingSelectorKey(item, selectors)
+
+		if first, ok := keys[key]; ok {
+			if len(selectors) > 0 {
+				result.addf(path, "items %d and %d have the same %s", first, i, strings.Join(selectors, ", "))
+			}
+			continue
+		}
+
+		keys[key] = i
+	}
+
+	if minUniqueItems, ok := jsonInt(s["minUniqueItems"]); ok && len(keys) < minUniqueItems {
+		result.addf(path, "must have at least %d unique items, got %d", minUniqueItems, len(keys))
+	}
+
+	if maxUniqueItems, ok := jsonInt(s["maxUniqueItems"]); ok && len(keys) > maxUniqueItems {
+		result.addf(path, "must have at most %d unique items, got %d", maxUniqueItems, len(keys))
+	}
+}
+
+// listingSelectorKey identifies an array item by the values of its selector
+// properties, or by the whole item when there are no selectors.
+func listingSelectorKey(item any, selectors []string) string {
+	if len(selectors) == 0 {
+		return compactJSON(canonicalJSONValue(item))
+	}
+
+	object, _ := item.(map[string]any)
+
+	parts := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		parts = append(parts, compactJSON(canonicalJSONValue(object[selector])))
+	}
+
+	return strings.Join(parts, "\x00")
+}
+
+func (v *listingSchemaValidator) validateString(s map[string]any, str string, path string, result *listingSchemaResult) {
+	length := utf8.RuneCountInString(str)
+
+	if minLength, ok := jsonInt(s["minLength"]); ok && length < minLength {
+		result.addf(path, "must be at least %d characters long", minLength)
+	}
+
+	if maxLength, ok := jsonInt(s["maxLength"]); ok && length > maxLength {
+		result.addf(path, "must be at most %d characters long", maxLength)
+	}
+
+	if pattern, ok := s["pattern"].(string); ok {
+		// ECMA-262 patterns RE2 cannot compile, e.g. with lookarounds, are skipped.
+		if re := v.pattern(pattern); re != nil && !re.MatchString(str) {
+			result.addf(path, "must match pattern %s", pattern)
+		}
+	}
+}
+
+func (v *listingSchemaValidator) pattern(pattern string) *regexp.Regexp {
+	re, ok := v.patterns[pattern]
+	if !ok {
+		re, _ = regexp.Compile(pattern)
+		v.patterns[pattern] = re
+	}
+
+	return re
+}
+
+func (v *listingSchemaValidator) validateNumber(s map[string]any, number json.Number, path string, result *listingSchemaResult) {
+	n, err := number.Float64()
+	if err != nil {
+		result.addf(path, "%s is not a valid number", number)
+		return
+	}
+
+	if minimum, ok := jsonFloat(s["minimum"]); ok && n < minimum {
+		result.addf(path, "must be at least %v", minimum)
+	}
+
+	if maximum, ok := jsonFloat(s["maximum"]); ok && n > maximum {
+		result.addf(path, "must be at most %v", maximum)
+	}
+
+	if exclusiveMinimum, ok := jsonFloat(s["exclusiveMinimum"]); ok && n <= exclusiveMinimum {
+		result.addf(path, "must be greater than %v", exclusiveMinimum)
+	}
+
+	if exclusiveMaximum, ok := jsonFloat(s["exclusiveMaximum"]); ok && n >= exclusiveMaximum {
+		result.addf(path, "must be less than %v", exclusiveMaximum)
+	}
+
+	if multipleOf, ok := jsonFloat(s["multipleOf"]); ok && multipleOf > 0 {
+		quotient := n / multipleOf
+		if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			result.addf(path, "must be a multiple of %v", multipleOf)
+		}
+	}
+}
+
+// resolve looks up a local $ref such as #/$defs/marketplace_id.
+func (v *listingSchemaValidator) resolve(ref string) (any, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false
+	}
+
+	current := v.root
+
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/") {
+		if token == "" {
+			continue
+		}
+
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		if current, ok = object[token]; !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func matchesJSONType(t any, value any) bool {
+	switch t := t.(type) {
+	case string:
+		return jsonValueHasType(value, t)
+	case []any:
+		for _, name := range t {
+			if name, ok := name.(string); ok && jsonValueHasType(value, name) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func jsonValueHasType(value any, t string) bool {
+	actual := jsonTypeOf(value)
+	if t == "number" && actual == "integer" {
+		return true
+	}
+
+	return actual == t
+}
+
+func jsonTypeOf(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case json.Number:
+		if n, err := typed.Float64(); err == nil && n == math.Trunc(n) {
+			return "integer"
+		}
+
+		return "number"
+	}
+
+	return "unknown"
+}
+
+func describeJSONType(t any) string {
+	if names, ok := t.([]any); ok {
+		parts := make([]string, 0, len(names))
+		for _, name := range names {
+			parts = append(parts, fmt.Sprint(name))
+		}
+
+		return strings.Join(parts, " or ")
+	}
+
+	return fmt.Sprint(t)
+}
+
+func jsonInt(value any) (int, bool) {
+	n, ok := jsonFloat(value)
+	if !ok {
+		return 0, false
+	}
+
+	return int(n), true
+}
+
+func jsonFloat(value any) (float64, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+
+	n, err := number.Float64()
+	return n, err == nil
+}
+
+// jsonValuesEqual compares decoded JSON values, treating numbers by value.
+func jsonValuesEqual(a any, b any) bool {
+	return compactJSON(canonicalJSONValue(a)) == compactJSON(canonicalJSONValue(b))
+}
+
+// canonicalJSONValue normalizes numbers so equal values encode identically.
+func canonicalJSONValue(value any) any {
+	switch typed := value.(type) {
+	case json.Number:
+		if n, err := typed.Float64(); err == nil {
+			return n
+		}
+	case []any:
+		canonical := make([]any, len(typed))
+		for i, item := range typed {
+			canonical[i] = canonicalJSONValue(item)
+		}
+
+		return canonical
+	case map[string]any:
+		canonical := make(map[string]any, len(typed))
+		for key, item := range typed {
+			canonical[key] = canonicalJSONValue(item)
+		}
+
+		return canonical
+	}
+
+	return value
+}
+
+func compactJSON(value any) string {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(buf)
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestValidateListingSchema(t *testing.T) {
+	schemaJSON, err := os.ReadFile("testdata/product_type_schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		attributes string
+		violations []string
+	}{
+		{
+			name: "valid",
+			attributes: `{
+				"item_name": [{"value": "Mug", "language_tag": "en_US", "marketplace_id": "ATVPDKIKX0DER"}],
+				"brand": [{"value": "Acme"}]
+			}`,
+		},
+		{
+			name:       "missing required attribute",
+			attributes: `{"item_name": [{"value": "Mug", "language_tag": "en_US"}]}`,
+			violations: []string{`: missing required property "brand"`},
+		},
+		{
+			name: "local refs and additional properties",
+			attributes: `{
+				"item_name": [{"value": "Mug", "language_tag": "fr_FR", "color": "Red"}],
+				"brand": [{"value": "Acme"}]
+			}`,
+			violations: []string{
+				"/item_name/0/color: property \"color\" is not allowed",
+				"/item_name/0/language_tag: value is not one of the allowed values",
+			},
+		},
+		{
+			name: "duplicate selectors",
+			attributes: `{
+				"item_name": [{"value": "Mug", "language_tag": "en_US"}, {"value": "Cup", "language_tag": "en_US"}],
+				"brand": [{"value": "Acme"}]
+			}`,
+			violations: []string{"/item_name: items 0 and 1 have the same marketplace_id, language_tag"},
+		},
+		{
+			name: "max unique items",
+			attributes: `{
+				"item_name": [{"value": "Mug", "language_tag": "en_US"}],
+				"brand": [{"value": "Acme"}, {"value": "Acme", "marketplace_id": "ATVPDKIKX0DER"}]
+			}`,
+			violations: []string{"/brand: must have at most 1 unique items, got 2"},
+		},
+		{
+			name: "numbers, strings and conditionals",
+			attributes: `{
+				"item_name": [{"value": "A very long mug name indeed", "language_tag": "en_US"}],
+				"brand": [{"value": "Acme"}],
+				"capacity": [{"value": 0, "unit": "milliliters"}]
+			}`,
+			violations: []string{
+				`: missing required property "color"`,
+				"/capacity/0/value: must be greater than 0",
+				"/item_name/0/value: must be at most 20 characters long",
+			},
+		},
+		{
+			name: "type mismatch and pattern",
+			attributes: `{
+				"item_name": {"value": "Mug"},
+				"brand": [{"value": "Acme"}],
+				"capacity": [{"value": "350", "unit": "milliliters"}],
+				"color": [{"value": "Red #1"}]
+			}`,
+			violations: []string{
+				"/capacity/0/value: expected number, got string",
+				"/color/0/value: must match pattern ^[A-Za-z ]+$",
+				"/item_name: expected array, got object",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validateListingSchema(schemaJSON, []byte(tt.attributes))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := []string{}
+			for _, violation := range result.Violations {
+				got = append(got, violation.Path+": "+violation.Message)
+			}
+
+			want := append([]string{}, tt.violations...)
+			if len(want) == 0 {
+				want = []string{}
+			}
+
+			if !reflect.DeepEqual(sortedUnique(got), sortedUnique(want)) {
+				t.Errorf("violations:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidateListingSchemaAnnotations(t *testing.T) {
+	schemaJSON, err := os.ReadFile("testdata/product_type_schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := validateListingSchema(schemaJSON, []byte(`{
+		"item_name": [{"value": "Mug", "language_tag": "en_US"}],
+		"brand": [{"value": "Acme"}],
+		"supplier_declared_dg_hz_regulation": [{"value": "not_applicable"}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"/brand/0/value"}; !reflect.DeepEqual(result.NonEditable, want) {
+		t.Errorf("non-editable paths = %v, want %v", result.NonEditable, want)
+	}
+
+	if want := []string{"/supplier_declared_dg_hz_regulation"}; !reflect.DeepEqual(result.Hidden, want) {
+		t.Errorf("hidden paths = %v, want %v", result.Hidden, want)
+	}
+}
+
+func TestValidateListingSchemaCircularRef(t *testing.T) {
+	schemaJSON := []byte(`{
+		"$ref": "#",
+		"type": "object",
+		"properties": {
+			"item_name": {"$ref": "#/$defs/name"},
+			"parts": {"type": "array", "items": {"$ref": "#"}}
+		},
+		"$defs": {
+			"name": {"$ref": "#/$defs/alias", "type": "string"},
+			"alias": {"$ref": "#/$defs/name", "maxLength": 5}
+		}
+	}`)
+
+	result, err := validateListingSchema(schemaJSON, []byte(`{"item_name": "Too long", "parts": [{"item_name": 1}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var violations []string
+	for _, violation := range result.Violations {
+		violations = append(violations, violation.Path+": "+violation.Message)
+	}
+
+	want := []string{
+		"/item_name: must be at most 5 characters long",
+		"/parts/0/item_name: expected string, got integer",
+	}
+
+	if !reflect.DeepEqual(violations, want) {
+		t.Errorf("got violations %q, want %q", violations, want)
+	}
+}
+
+func TestValidateListingSchemaUnresolvedRef(t *testing.T) {
+	schemaJSON := []byte(`{
+		"type": "object",
+		"properties": {
+			"item_name": {"$ref": "#/$defs/missing"},
+			"brand": {"$ref": "https://schemas.example.com/brand.json"}
+		}
+	}`)
+
+	result, err := validateListingSchema(schemaJSON, []byte(`{"item_name": "Mug", "brand": "Acme"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var violations []string
+	for _, violation := range result.Violations {
+		violations = append(violations, violation.Path+": "+violation.Message)
+	}
+
+	sort.Strings(violations)
+
+	want := []string{
+		"/brand: schema reference https://schemas.example.com/brand.json cannot be resolved",
+		"/item_name: schema reference #/$defs/missing cannot be resolved",
+	}
+
+	if !reflect.DeepEqual(violations, want) {
+		t.Errorf("got violations %q, want %q", violations, want)
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// listingValidationRequest describes a listing to be checked with the
+// VALIDATION_PREVIEW mode of putListingsItem.
+type listingValidationRequest struct {
+	SellerID       string
+	SKU            string
+	MarketplaceIDs []string
+	ProductType    string
+	Requirements   string
+	Attributes     string
+}
+
+// validateListingPreview submits the listing in VALIDATION_PREVIEW mode, so
+// nothing is changed on Amazon, and reports every returned issue as a
+// diagnostic on the attribute it refers to below attributesPath. ERROR issues
+// are only reported as errors if failOnError is set, and as warnings
+// otherwise. Resources managing listings call it from ModifyPlan with
+// failOnError set, so invalid listings fail at plan time.
+func validateListingPreview(ctx context.Context, client *listingsItemsClient, req listingValidationRequest, attributesPath path.Path, failOnError bool) (*listingsItemSubmissionResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes, err := decodeListingAttributes(req.Attributes)
+	if err != nil {
+		diags.AddAttributeError(attributesPath, "Invalid listing attributes", err.Error())
+		return nil, diags
+	}
+
+	submission, err := client.PutListingsItem(ctx, req.SellerID, req.SKU, req.MarketplaceIDs, "VALIDATION_PREVIEW", listingsItemPutRequest{
+		ProductType:  req.ProductType,
+		Requirements: req.Requirements,
+		Attributes:   attributes,
+	})
+	if err != nil {
+		diags.AddError("Error validating listing", err.Error())
+		return nil, diags
+	}
+
+	diags.Append(listingValidationIssueDiagnostics(submission.Issues, attributesPath, failOnError)...)
+
+	return submission, diags
+}
+
+// listingValidationIssueDiagnostics turns validation issues into diagnostics
+// on attributesPath.AtName of the first attribute each issue names.
+func listingValidationIssueDiagnostics(issues []listingIssue, attributesPath path.Path, failOnError bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, issue := range issues {
+		summary := fmt.Sprintf("Listing validation %s: %s", strings.ToLower(issue.Severity), issue.Code)
+		detail := issue.Message
+
+		for _, name := range issue.AttributeNames {
+			detail += fmt.Sprintf("\n\nAttribute: /attributes/%s", name)
+		}
+
+		issuePath := attributesPath
+		if len(issue.AttributeNames) > 0 {
+			issuePath = attributesPath.AtName(issue.AttributeNames[0])
+		}
+
+		switch {
+		case issue.Severity == "ERROR" && failOnError:
+			diags.AddAttributeError(issuePath, summary, detail)
+		case issue.Severity == "ERROR" || issue.Severity == "WARNING":
+			diags.AddAttributeWarning(issuePath, summary, detail)
+		}
+	}
+
+	return diags
+}
+
+// isFullyKnown reports whether value and all values nested in it are known.
+func isFullyKnown(ctx context.Context, value attr.Value) bool {
+	tfValue, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return false
+	}
+
+	return tfValue.IsFullyKnown()
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &listingValidationDatasource{}
+	_ datasource.DataSourceWithConfigure = &listingValidationDatasource{}
+)
+
+func NewListingValidationDatasource() datasource.DataSource {
+	return &listingValidationDatasource{}
+}
+
+type listingValidationDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type listingValidationDataSourceModel struct {
+	SellerID       types.String           `tfsdk:"seller_id"`
+	SKU            types.String           `tfsdk:"sku"`
+	MarketplaceIDs []types.String         `tfsdk:"marketplace_ids"`
+	ProductType    types.String           `tfsdk:"product_type"`
+	Requirements   types.String           `tfsdk:"requirements"`
+	Attributes     listingAttributesValue `tfsdk:"attributes"`
+	FailOnError    types.Bool             `tfsdk:"fail_on_error"`
+	Status         types.String           `tfsdk:"status"`
+	Valid          types.Bool             `tfsdk:"valid"`
+	Issues         []listingIssueModel    `tfsdk:"issues"`
+}
+
+func listingIssueSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"code": schema.StringAttribute{
+			Computed: true,
+		},
+		"message": schema.StringAttribute{
+			Computed: true,
+		},
+		"severity": schema.StringAttribute{
+			Computed: true,
+		},
+		"attribute_names": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"categories": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (d *listingValidationDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *listingValidationDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_listing_validation"
+}
+
+func (d *listingValidationDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Validates a listing with the VALIDATION_PREVIEW mode of putListingsItem without submitting it.",
+		Attributes: map[string]schema.Attribute{
+			"seller_id": schema.StringAttribute{
+				Required: true,
+			},
+			"sku": schema.StringAttribute{
+				Required: true,
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"product_type": schema.StringAttribute{
+				Required: true,
+			},
+			"requirements": schema.StringAttribute{
+				Optional: true,
+			},
+			"attributes": schema.StringAttribute{
+				Required:    true,
+				CustomType:  listingAttributesType{},
+				Description: "Listing attributes as a JSON object.",
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail with an error for every ERROR issue. By default they are only reported as warnings, so valid and issues can be used.",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"valid": schema.BoolAttribute{
+				Computed: true,
+			},
+			"issues": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listingIssueSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *listingValidationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state listingValidationDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	marketplaceIDs := make([]string, 0, len(state.MarketplaceIDs))
+	for _, id := range state.MarketplaceIDs {
+		marketplaceIDs = append(marketplaceIDs, id.ValueString())
+	}
+
+	client := newListingsItemsClient(d.sellingPartner, d.region)
+
+	submission, diags := validateListingPreview(ctx, client, listingValidationRequest{
+		SellerID:       state.SellerID.ValueString(),
+		SKU:            state.SKU.ValueString(),
+		MarketplaceIDs: marketplaceIDs,
+		ProductType:    state.ProductType.ValueString(),
+		Requirements:   state.Requirements.ValueString(),
+		Attributes:     state.Attributes.ValueString(),
+	}, path.Root("attributes"), state.FailOnError.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if submission == nil {
+		return
+	}
+
+	state.Status = types.StringValue(submission.Status)
+	state.Valid = types.BoolValue(submission.Status == "VALID")
+	state.Issues = newListingIssueModels(submission.Issues)
+
+	// The state is set even when fail_on_error reports issues as errors, so
+	// they can still be inspected.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestListingValidationIssueDiagnostics(t *testing.T) {
+	issues := []listingIssue{
+		{Code: "90220", Message: "'item_name' is required but not supplied.", Severity: "ERROR", AttributeNames: []string{"item_name"}},
+		{Code: "18027", Message: "Conflicting values.", Severity: "WARNING", AttributeNames: []string{"brand", "manufacturer"}},
+		{Code: "100", Message: "General issue.", Severity: "ERROR"},
+		{Code: "200", Message: "Informational.", Severity: "INFO"},
+	}
+
+	diags := listingValidationIssueDiagnostics(issues, path.Root("attributes"), false)
+	if diags.HasError() || diags.WarningsCount() != 3 {
+		t.Fatalf("got %d errors and %d warnings, want only 3 warnings", diags.ErrorsCount(), diags.WarningsCount())
+	}
+
+	diags = listingValidationIssueDiagnostics(issues, path.Root("attributes"), true)
+	if diags.ErrorsCount() != 2 || diags.WarningsCount() != 1 {
+		t.Fatalf("got %d errors and %d warnings, want 2 errors and 1 warning", diags.ErrorsCount(), diags.WarningsCount())
+	}
+
+	wantPaths := []path.Path{
+		path.Root("attributes").AtName("item_name"),
+		path.Root("attributes").AtName("brand"),
+		path.Root("attributes"),
+	}
+
+	for i, d := range diags {
+		withPath, ok := d.(interface{ Path() path.Path })
+		if !ok || !withPath.Path().Equal(wantPaths[i]) {
+			t.Errorf("diagnostic %d: got %v, want path %s", i, d, wantPaths[i])
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// listingsFeedItem is a listings item as submitted through JSON_LISTINGS_FEED.
+type listingsFeedItem struct {
+	ProductType  string
+	Requirements string
+	Attributes   map[string]json.RawMessage
+}
+
+type listingsFeedHeader struct {
+	SellerID    string `json:"sellerId"`
+	Version     string `json:"version"`
+	IssueLocale string `json:"issueLocale,omitempty"`
+}
+
+type listingsFeedMessage struct {
+	MessageID     int64                      `json:"messageId"`
+	SKU           string                     `json:"sku"`
+	OperationType string                     `json:"operationType"`
+	ProductType   string                     `json:"productType,omitempty"`
+	Requirements  string                     `json:"requirements,omitempty"`
+	Attributes    map[string]json.RawMessage `json:"attributes,omitempty"`
+	Patches       []listingsPatchOperation   `json:"patches,omitempty"`
+}
+
+type listingsFeedDocument struct {
+	Header   listingsFeedHeader    `json:"header"`
+	Messages []listingsFeedMessage `json:"messages"`
+}
+
+// listingsFeedMessages computes the messages that turn the prior items into
+// the planned ones: UPDATE for new items and items with a different product
+// type or requirements, PATCH for changed attributes and DELETE for removed
+// items. Unchanged items produce no message. Messages are ordered by SKU.
+func listingsFeedMessages(prior map[string]listingsFeedItem, planned map[string]listingsFeedItem, defaults listingAttributeDefaults) []listingsFeedMessage {
+	skus := make([]string, 0, len(prior)+len(planned))
+	for sku := range prior {
+		skus = append(skus, sku)
+	}
+	for sku := range planned {
+		if _, ok := prior[sku]; !ok {
+			skus = append(skus, sku)
+		}
+	}
+
+	sort.Strings(skus)
+
+	messages := []listingsFeedMessage{}
+
+	for _, sku := range skus {
+		priorItem, inPrior := prior[sku]
+		plannedItem, inPlanned := planned[sku]
+
+		message := listingsFeedMessage{
+			MessageID: int64(len(messages) + 1),
+			SKU:       sku,
+		}
+
+		switch {
+		case !inPlanned:
+			message.OperationType = "DELETE"
+		case !inPrior || priorItem.ProductType != plannedItem.ProductType || priorItem.Requirements != plannedItem.Requirements:
+			message.OperationType = "UPDATE"
+			message.ProductType = plannedItem.ProductType
+			message.Requirements = plannedItem.Requirements
+			message.Attributes = plannedItem.Attributes
+		default:
+			patches := listingAttributePatches(priorItem.Attributes, plannedItem.Attributes, defaults)
+			if len(patches) == 0 {
+				continue
+			}
+
+			message.OperationType = "PATCH"
+			message.ProductType = plannedItem.ProductType
+			message.Patches = patches
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+// listingsFeedReport is the processing report of a JSON_LISTINGS_FEED.
+type listingsFeedReport struct {
+	Header struct {
+		SellerID string `json:"sellerId"`
+		Version  string `json:"version"`
+		FeedID   string `json:"feedId"`
+	} `json:"header"`
+	Issues  []listingsFeedReportIssue `json:"issues"`
+	Summary struct {
+		Errors            int64 `json:"errors"`
+		Warnings          int64 `json:"warnings"`
+		MessagesProcessed int64 `json:"messagesProcessed"`
+		MessagesAccepted  int64 `json:"messagesAccepted"`
+		MessagesInvalid   int64 `json:"messagesInvalid"`
+	} `json:"summary"`
+}
+
+type listingsFeedReportIssue struct {
+	MessageID int64 `json:"messageId"`
+	listingIssue
+}
+
+// listingsFeedMessageResult is the outcome of one message of a feed.
+type listingsFeedMessageResult struct {
+	MessageID     int64
+	OperationType string
+	Accepted      bool
+	Issues        []listingIssue
+}
+
+// listingsFeedResults maps the issues of a processing report back to the SKUs
+// of the submitted messages. A message is accepted unless it has an ERROR
+// issue. A nil report, for example of a FATAL feed, accepts no message.
+func listingsFeedResults(messages []listingsFeedMessage, report *listingsFeedReport) map[string]listingsFeedMessageResult {
+	results := make(map[string]listingsFeedMessageResult, len(messages))
+	skus := make(map[int64]string, len(messages))
+
+	for _, message := range messages {
+		skus[message.MessageID] = message.SKU
+		results[message.SKU] = listingsFeedMessageResult{
+			MessageID:     message.MessageID,
+			OperationType: message.OperationType,
+			Accepted:      report != nil,
+			Issues:        []listingIssue{},
+		}
+	}
+
+	if report == nil {
+		return results
+	}
+
+	for _, issue := range report.Issues {
+		sku, ok := skus[issue.MessageID]
+		if !ok {
+			continue
+		}
+
+		result := results[sku]
+		result.Issues = append(result.Issues, issue.listingIssue)
+		if issue.Severity == "ERROR" {
+			result.Accepted = false
+		}
+
+		results[sku] = result
+	}
+
+	return results
+}
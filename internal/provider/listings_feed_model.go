@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listingsFeedModel struct {
+	ID                types.String                       `tfsdk:"id"`
+	SellerID          types.String                       `tfsdk:"seller_id"`
+	MarketplaceIDs    []types.String                     `tfsdk:"marketplace_ids"`
+	IssueLocale       types.String                       `tfsdk:"issue_locale"`
+	Items             map[string]listingsFeedItemModel   `tfsdk:"items"`
+	ProcessingTimeout types.String                       `tfsdk:"processing_timeout"`
+	FeedID            types.String                       `tfsdk:"feed_id"`
+	ProcessingStatus  types.String                       `tfsdk:"processing_status"`
+	Results           map[string]listingsFeedResultModel `tfsdk:"results"`
+}
+
+type listingsFeedItemModel struct {
+	ProductType  types.String           `tfsdk:"product_type"`
+	Requirements types.String           `tfsdk:"requirements"`
+	Attributes   listingAttributesValue `tfsdk:"attributes"`
+}
+
+type listingsFeedResultModel struct {
+	MessageID     types.Int64         `tfsdk:"message_id"`
+	OperationType types.String        `tfsdk:"operation_type"`
+	Status        types.String        `tfsdk:"status"`
+	Issues        []listingIssueModel `tfsdk:"issues"`
+}
+
+func (m listingsFeedModel) marketplaceIDs() []string {
+	ids := make([]string, 0, len(m.MarketplaceIDs))
+	for _, id := range m.MarketplaceIDs {
+		ids = append(ids, id.ValueString())
+	}
+
+	return ids
+}
+
+// feedItems decodes the configured items for listingsFeedMessages.
+func (m listingsFeedModel) feedItems() (map[string]listingsFeedItem, error) {
+	items := make(map[string]listingsFeedItem, len(m.Items))
+
+	for sku, item := range m.Items {
+		attributes, err := decodeListingAttributes(item.Attributes.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		items[sku] = listingsFeedItem{
+			ProductType:  item.ProductType.ValueString(),
+			Requirements: item.Requirements.ValueString(),
+			Attributes:   attributes,
+		}
+	}
+
+	return items, nil
+}
+
+func newListingsFeedResultModel(result listingsFeedMessageResult) listingsFeedResultModel {
+	status := "INVALID"
+	if result.Accepted {
+		status = "ACCEPTED"
+	}
+
+	return listingsFeedResultModel{
+		MessageID:     types.Int64Value(result.MessageID),
+		OperationType: types.StringValue(result.OperationType),
+		Status:        types.StringValue(status),
+		Issues:        newListingIssueModels(result.Issues),
+	}
+}
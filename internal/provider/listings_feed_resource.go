@@ -0,0 +1,477 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &listingsFeedResource{}
+	_ resource.ResourceWithConfigure  = &listingsFeedResource{}
+	_ resource.ResourceWithModifyPlan = &listingsFeedResource{}
+)
+
+// NewListingsFeedResource is a helper function to simplify the provider implementation.
+func NewListingsFeedResource() resource.Resource {
+	return &listingsFeedResource{}
+}
+
+// listingsFeedResource is the resource implementation.
+type listingsFeedResource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+// Metadata returns the resource type name.
+func (r *listingsFeedResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_listings_feed"
+}
+
+func (r *listingsFeedResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.sellingPartner = providerData.SellingPartner
+	r.region = providerData.Region
+}
+
+// Schema defines the schema for the resource.
+func (r *listingsFeedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages many listings items through a single JSON_LISTINGS_FEED per apply. Only the items that " +
+			"changed since the last apply are submitted, as UPDATE, PATCH or DELETE messages. The listings are not " +
+			"read back from Amazon, so changes made outside of Terraform are not detected. Messages Amazon rejects " +
+			"are reported as warnings, listed as INVALID in results and submitted again by the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"seller_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_locale": schema.StringAttribute{
+				Optional:    true,
+				Description: "Locale of the issue messages in the processing report, e.g. en_US.",
+			},
+			"items": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Listings items by SKU.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"product_type": schema.StringAttribute{
+							Required: true,
+						},
+						"requirements": schema.StringAttribute{
+							Optional:    true,
+							Description: "Requirements level of the item: LISTING, LISTING_PRODUCT_ONLY or LISTING_OFFER_ONLY.",
+						},
+						"attributes": schema.StringAttribute{
+							Required:    true,
+							CustomType:  listingAttributesType{},
+							Description: "Listing attributes as a JSON object, as described by the product type definition.",
+						},
+					},
+				},
+			},
+			"processing_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("30m"),
+				Description: "How long to wait for a feed to be processed, as a Go duration.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"feed_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the last submitted feed.",
+			},
+			"processing_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Processing status of the last submitted feed.",
+			},
+			"results": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Outcome of the last message submitted for each SKU.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"message_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"operation_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "ACCEPTED, or INVALID if the message had errors.",
+						},
+						"issues": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"code": schema.StringAttribute{
+										Computed: true,
+									},
+									"message": schema.StringAttribute{
+										Computed: true,
+									},
+									"severity": schema.StringAttribute{
+										Computed: true,
+									},
+									"attribute_names": schema.ListAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+									"categories": schema.ListAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *listingsFeedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan listingsFeedModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.SellerID
+
+	state := r.submit(ctx, listingsFeedModel{FeedID: types.StringNull(), ProcessingStatus: types.StringNull()}, plan, &resp.Diagnostics)
+	if state == nil {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the Terraform state as is. Reading thousands of listings items
+// back would exhaust the rate limits the feed exists to avoid.
+func (r *listingsFeedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state listingsFeedModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *listingsFeedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state listingsFeedModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	newState := r.submit(ctx, state, plan, &resp.Diagnostics)
+	if newState == nil {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *listingsFeedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state listingsFeedModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan := state
+	plan.Items = nil
+
+	newState := r.submit(ctx, state, plan, &resp.Diagnostics)
+	if newState == nil {
+		return
+	}
+
+	// Keep the items that could not be deleted in the state.
+	for sku, itemResult := range newState.Results {
+		if item, ok := state.Items[sku]; ok && itemResult.Status.ValueString() == "INVALID" {
+			newState.Items[sku] = item
+		}
+	}
+
+	if len(newState.Items) > 0 && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError(
+			"Error deleting listings items",
+			fmt.Sprintf("%d listings items could not be deleted and are kept in the state. See the warnings for details.", len(newState.Items)),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		diags = resp.State.Set(ctx, newState)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// ModifyPlan plans an update when a message of the last feed was rejected, so
+// that it is submitted again even though the configuration is unchanged.
+func (r *listingsFeedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var results map[string]listingsFeedResultModel
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("results"), &results)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, itemResult := range results {
+		if itemResult.Status.ValueString() == "INVALID" {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("feed_id"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("processing_status"), types.StringUnknown())...)
+			return
+		}
+	}
+}
+
+// submit sends the messages that turn the items of state into the items of
+// plan as one feed and returns the resulting state. Messages rejected by
+// Amazon are reported as warnings and kept as INVALID in results, so that
+// they are submitted again by the next apply, see ModifyPlan. Only a failure
+// of the feed as a whole is an error, in which case the items keep their
+// prior value. It returns nil if nothing was submitted because of an error.
+func (r *listingsFeedResource) submit(ctx context.Context, state listingsFeedModel, plan listingsFeedModel, diags *diag.Diagnostics) *listingsFeedModel {
+	prior, err := state.feedItems()
+	if err != nil {
+		diags.AddError("Error decoding listing attributes", err.Error())
+		return nil
+	}
+
+	// Resubmit rejected messages: a rejected UPDATE or PATCH as an UPDATE
+	// with all attributes, a rejected DELETE as another DELETE.
+	for sku, itemResult := range state.Results {
+		if itemResult.Status.ValueString() != "INVALID" {
+			continue
+		}
+
+		_, inPlan := plan.Items[sku]
+
+		switch {
+		case itemResult.OperationType.ValueString() == "DELETE":
+			if _, ok := prior[sku]; !ok {
+				prior[sku] = listingsFeedItem{}
+			}
+		case inPlan:
+			delete(prior, sku)
+		}
+	}
+
+	planned, err := plan.feedItems()
+	if err != nil {
+		diags.AddError("Error decoding listing attributes", err.Error())
+		return nil
+	}
+
+	result := plan
+	result.FeedID = state.FeedID
+	result.ProcessingStatus = state.ProcessingStatus
+
+	result.Items = make(map[string]listingsFeedItemModel, len(plan.Items))
+	for sku, item := range plan.Items {
+		result.Items[sku] = item
+	}
+
+	result.Results = map[string]listingsFeedResultModel{}
+	for sku, itemResult := range state.Results {
+		if _, ok := plan.Items[sku]; ok {
+			result.Results[sku] = itemResult
+		}
+	}
+
+	messages := listingsFeedMessages(prior, planned, newListingAttributeDefaults(plan.marketplaceIDs()))
+	if len(messages) == 0 {
+		return &result
+	}
+
+	content, err := json.Marshal(listingsFeedDocument{
+		Header: listingsFeedHeader{
+			SellerID:    plan.SellerID.ValueString(),
+			Version:     "2.0",
+			IssueLocale: plan.IssueLocale.ValueString(),
+		},
+		Messages: messages,
+	})
+	if err != nil {
+		diags.AddError("Error encoding listings feed", err.Error())
+		return nil
+	}
+
+	client := newFeedsClient(r.sellingPartner, r.region)
+
+	feedID, err := submitFeed(ctx, client, "JSON_LISTINGS_FEED", plan.marketplaceIDs(), "application/json", content, nil)
+	if err != nil {
+		diags.AddError("Error creating listings feed", err.Error())
+		return nil
+	}
+
+	result.FeedID = types.StringValue(feedID)
+
+	feed, err := waitForFeed(ctx, client, feedID, plan.ProcessingTimeout.ValueString())
+	if err != nil {
+		// The outcome is unknown, so every item is submitted again next time.
+		diags.AddError("Error waiting for listings feed processing", err.Error())
+		result.ProcessingStatus = types.StringValue("IN_PROGRESS")
+		revertListingsFeedItems(&result, state, messages)
+		return &result
+	}
+
+	result.ProcessingStatus = types.StringValue(feed.ProcessingStatus)
+
+	var report *listingsFeedReport
+
+	if feed.ResultFeedDocumentID != nil {
+		content, err := getFeedDocument(ctx, client, *feed.ResultFeedDocumentID)
+		if err != nil {
+			diags.AddError("Error getting listings feed processing report", err.Error())
+		} else if err := json.Unmarshal(content, &report); err != nil {
+			diags.AddError("Error decoding listings feed processing report", err.Error())
+			report = nil
+		}
+	}
+
+	if feed.ProcessingStatus != "DONE" {
+		diags.AddError(
+			"Listings feed processing failed",
+			fmt.Sprintf("Feed %s finished with status %s, none of its messages were applied.", feedID, feed.ProcessingStatus),
+		)
+		report = nil
+	} else if feed.ResultFeedDocumentID == nil {
+		diags.AddError("Missing listings feed processing report", fmt.Sprintf("Feed %s has no processing report, so the outcome of its messages is unknown.", feedID))
+	}
+
+	results := listingsFeedResults(messages, report)
+
+	skus := make([]string, 0, len(results))
+	for sku := range results {
+		skus = append(skus, sku)
+	}
+
+	sort.Strings(skus)
+
+	for _, sku := range skus {
+		messageResult := results[sku]
+
+		summary := fmt.Sprintf("Listings feed issue for SKU %s", sku)
+		for _, issue := range messageResult.Issues {
+			diags.AddWarning(summary, fmt.Sprintf("%s message %d: %s: %s: %s", messageResult.OperationType, messageResult.MessageID, issue.Severity, issue.Code, issue.Message))
+		}
+
+		if messageResult.Accepted && messageResult.OperationType == "DELETE" {
+			delete(result.Results, sku)
+			continue
+		}
+
+		result.Results[sku] = newListingsFeedResultModel(messageResult)
+	}
+
+	if report == nil {
+		// The feed failed as a whole, so every item is submitted again next time.
+		revertListingsFeedItems(&result, state, messages)
+		return &result
+	}
+
+	for _, issue := range report.Issues {
+		if _, ok := results[issueSKU(messages, issue.MessageID)]; ok {
+			continue
+		}
+
+		diags.AddWarning("Listings feed issue", fmt.Sprintf("%s: %s: %s", issue.Severity, issue.Code, issue.Message))
+	}
+
+	return &result
+}
+
+// revertListingsFeedItems restores the prior items of the SKUs of messages.
+func revertListingsFeedItems(result *listingsFeedModel, state listingsFeedModel, messages []listingsFeedMessage) {
+	for _, message := range messages {
+		if item, ok := state.Items[message.SKU]; ok {
+			result.Items[message.SKU] = item
+		} else {
+			delete(result.Items, message.SKU)
+		}
+	}
+}
+
+// issueSKU returns the SKU of the message with messageID, or an empty string
+// for issues about the feed as a whole.
+func issueSKU(messages []listingsFeedMessage, messageID int64) string {
+	for _, message := range messages {
+		if message.MessageID == messageID {
+			return message.SKU
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// fakeSPAPI answers the requests of the default HTTP client, including the
+// LWA token request of the SDK, with the bodies in responses keyed by method
+// and path.
+func fakeSPAPI(t *testing.T, responses map[string]string) {
+	transport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	http.DefaultTransport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, ok := responses[req.Method+" "+req.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL)
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+}
+
+func TestListingsFeedResourceCreatePartlyRejected(t *testing.T) {
+	fakeSPAPI(t, map[string]string{
+		"POST /auth/o2/token":                      `{"access_token": "Atza|test", "expires_in": 3600}`,
+		"POST /feeds/2021-06-30/documents":         `{"feedDocumentId": "input-1", "url": "https://uploads.example.com/input-1"}`,
+		"PUT /input-1":                             ``,
+		"POST /feeds/2021-06-30/feeds":             `{"feedId": "50001"}`,
+		"GET /feeds/2021-06-30/feeds/50001":        `{"feedId": "50001", "feedType": "JSON_LISTINGS_FEED", "createdTime": "2024-04-01T00:00:00Z", "processingStatus": "DONE", "resultFeedDocumentId": "report-1"}`,
+		"GET /feeds/2021-06-30/documents/report-1": `{"feedDocumentId": "report-1", "url": "https://downloads.example.com/report-1"}`,
+		"GET /report-1": `{
+			"header": {"sellerId": "A1", "version": "2.0", "feedId": "50001"},
+			"issues": [{"messageId": 1, "code": "90220", "severity": "ERROR", "message": "'brand' is required but not supplied.", "attributeNames": ["brand"]}],
+			"summary": {"errors": 1, "warnings": 0, "messagesProcessed": 2, "messagesAccepted": 1, "messagesInvalid": 1}
+		}`,
+	})
+
+	sellingPartner, err := sp.NewSellingPartner(&sp.Config{ClientID: "client", ClientSecret: "secret", RefreshToken: "refresh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &listingsFeedResource{
+		sellingPartner: sellingPartner,
+		region:         spapiRegion{Endpoint: "https://sellingpartnerapi-na.amazon.com"},
+	}
+
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, listingsFeedModel{
+		ID:                types.StringUnknown(),
+		SellerID:          types.StringValue("A1"),
+		MarketplaceIDs:    []types.String{types.StringValue("ATVPDKIKX0DER")},
+		IssueLocale:       types.StringNull(),
+		ProcessingTimeout: types.StringValue("1m"),
+		FeedID:            types.StringUnknown(),
+		ProcessingStatus:  types.StringUnknown(),
+		Items: map[string]listingsFeedItemModel{
+			"MUG-1": {ProductType: types.StringValue("MUG"), Requirements: types.StringNull(), Attributes: newListingAttributesValue(`{"item_name": [{"value": "Mug"}]}`)},
+			"MUG-2": {ProductType: types.StringValue("MUG"), Requirements: types.StringNull(), Attributes: newListingAttributesValue(`{"item_name": [{"value": "Large mug"}], "brand": [{"value": "Acme"}]}`)},
+		},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("got error diagnostics for a rejected SKU: %v", resp.Diagnostics.Errors())
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("got %d warnings, want 1 for the rejected SKU: %v", resp.Diagnostics.WarningsCount(), resp.Diagnostics.Warnings())
+	}
+
+	var state listingsFeedModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(state.Items) != 2 {
+		t.Errorf("state has %d items, want both planned items", len(state.Items))
+	}
+
+	if got := state.Results["MUG-1"].Status.ValueString(); got != "INVALID" {
+		t.Errorf("MUG-1 status = %s, want INVALID", got)
+	}
+
+	if issues := state.Results["MUG-1"].Issues; len(issues) != 1 || issues[0].Code.ValueString() != "90220" {
+		t.Errorf("MUG-1 issues = %v, want the 90220 error", issues)
+	}
+
+	if got := state.Results["MUG-2"].Status.ValueString(); got != "ACCEPTED" {
+		t.Errorf("MUG-2 status = %s, want ACCEPTED", got)
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testListingsFeedItem(t *testing.T, productType string, attributes string) listingsFeedItem {
+	t.Helper()
+
+	decoded, err := decodeListingAttributes(attributes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return listingsFeedItem{ProductType: productType, Attributes: decoded}
+}
+
+func TestListingsFeedMessages(t *testing.T) {
+	prior := map[string]listingsFeedItem{
+		"MUG-1":   testListingsFeedItem(t, "DRINKING_CUP", `{"color": [{"value": "Red"}]}`),
+		"MUG-2":   testListingsFeedItem(t, "DRINKING_CUP", `{"color": [{"value": "Red"}]}`),
+		"MUG-3":   testListingsFeedItem(t, "DRINKING_CUP", `{"color": [{"value": "Red"}]}`),
+		"SHIRT-1": testListingsFeedItem(t, "SHIRT", `{"color": [{"value": "Red"}]}`),
+	}
+
+	planned := map[string]listingsFeedItem{
+		"MUG-1":   testListingsFeedItem(t, "DRINKING_CUP", `{"color": [{"value": "Red"}]}`),
+		"MUG-2":   testListingsFeedItem(t, "DRINKING_CUP", `{"color": [{"value": "Blue"}]}`),
+		"MUG-4":   testListingsFeedItem(t, "DRINKING_CUP", `{"color": [{"value": "Green"}]}`),
+		"SHIRT-1": testListingsFeedItem(t, "APPAREL", `{"color": [{"value": "Red"}]}`),
+	}
+
+	messages := listingsFeedMessages(prior, planned, newListingAttributeDefaults([]string{"ATVPDKIKX0DER"}))
+
+	want := []struct {
+		sku, operationType string
+	}{
+		{sku: "MUG-2", operationType: "PATCH"},
+		{sku: "MUG-3", operationType: "DELETE"},
+		{sku: "MUG-4", operationType: "UPDATE"},
+		{sku: "SHIRT-1", operationType: "UPDATE"},
+	}
+
+	if len(messages) != len(want) {
+		t.Fatalf("got %d messages, want %d: %+v", len(messages), len(want), messages)
+	}
+
+	for i, w := range want {
+		if messages[i].MessageID != int64(i+1) || messages[i].SKU != w.sku || messages[i].OperationType != w.operationType {
+			t.Errorf("message %d = %d %s %s, want %d %s %s", i, messages[i].MessageID, messages[i].SKU, messages[i].OperationType, i+1, w.sku, w.operationType)
+		}
+	}
+
+	if patches := messages[0].Patches; len(patches) != 1 || patches[0].Op != "replace" || patches[0].Path != "/attributes/color" {
+		t.Errorf("MUG-2 patches = %+v, want a replace of /attributes/color", patches)
+	}
+}
+
+func TestListingsFeedResults(t *testing.T) {
+	messages := []listingsFeedMessage{
+		{MessageID: 1, SKU: "MUG-1", OperationType: "UPDATE"},
+		{MessageID: 2, SKU: "MUG-2", OperationType: "PATCH"},
+	}
+
+	var report listingsFeedReport
+	err := json.Unmarshal([]byte(`{
+		"header": {"sellerId": "A1", "version": "2.0", "feedId": "123"},
+		"issues": [
+			{"messageId": 1, "code": "90220", "severity": "ERROR", "message": "'brand' is required but not supplied.", "attributeNames": ["brand"]},
+			{"messageId": 2, "code": "18027", "severity": "WARNING", "message": "Image is too small."}
+		],
+		"summary": {"errors": 1, "warnings": 1, "messagesProcessed": 2, "messagesAccepted": 1, "messagesInvalid": 1}
+	}`), &report)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := listingsFeedResults(messages, &report)
+
+	if results["MUG-1"].Accepted || len(results["MUG-1"].Issues) != 1 || results["MUG-1"].Issues[0].AttributeNames[0] != "brand" {
+		t.Errorf("MUG-1 = %+v, want rejected with the brand issue", results["MUG-1"])
+	}
+
+	if !results["MUG-2"].Accepted || len(results["MUG-2"].Issues) != 1 {
+		t.Errorf("MUG-2 = %+v, want accepted with a warning", results["MUG-2"])
+	}
+
+	for sku, result := range listingsFeedResults(messages, nil) {
+		if result.Accepted {
+			t.Errorf("%s accepted without a report", sku)
+		}
+	}
+}
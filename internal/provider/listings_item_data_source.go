@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &listingsItemDatasource{}
+	_ datasource.DataSourceWithConfigure = &listingsItemDatasource{}
+)
+
+// listingsItemIncludedData are the includedData values of getListingsItem.
+var listingsItemIncludedData = []string{"summaries", "attributes", "issues", "offers", "fulfillmentAvailability", "procurement"}
+
+var defaultListingsItemIncludedData = []string{"summaries", "attributes", "issues", "offers", "fulfillmentAvailability"}
+
+func NewListingsItemDatasource() datasource.DataSource {
+	return &listingsItemDatasource{}
+}
+
+type listingsItemDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type listingsItemDataSourceModel struct {
+	SellerID                types.String                           `tfsdk:"seller_id"`
+	SKU                     types.String                           `tfsdk:"sku"`
+	MarketplaceIDs          []types.String                         `tfsdk:"marketplace_ids"`
+	IncludedData            []types.String                         `tfsdk:"included_data"`
+	Summaries               []listingsItemSummaryModel             `tfsdk:"summaries"`
+	Attributes              listingAttributesValue                 `tfsdk:"attributes"`
+	Issues                  []listingIssueModel                    `tfsdk:"issues"`
+	Offers                  []listingsItemOfferModel               `tfsdk:"offers"`
+	FulfillmentAvailability []listingsItemFulfillmentQuantityModel `tfsdk:"fulfillment_availability"`
+	Procurement             []listingsItemProcurementModel         `tfsdk:"procurement"`
+}
+
+type listingsItemSummaryModel struct {
+	MarketplaceID   types.String   `tfsdk:"marketplace_id"`
+	ASIN            types.String   `tfsdk:"asin"`
+	ProductType     types.String   `tfsdk:"product_type"`
+	ConditionType   types.String   `tfsdk:"condition_type"`
+	Status          []types.String `tfsdk:"status"`
+	Buyable         types.Bool     `tfsdk:"buyable"`
+	Discoverable    types.Bool     `tfsdk:"discoverable"`
+	ItemName        types.String   `tfsdk:"item_name"`
+	CreatedDate     types.String   `tfsdk:"created_date"`
+	LastUpdatedDate types.String   `tfsdk:"last_updated_date"`
+}
+
+type listingsItemMoneyModel struct {
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	Amount       types.String `tfsdk:"amount"`
+}
+
+type listingsItemOfferModel struct {
+	MarketplaceID types.String           `tfsdk:"marketplace_id"`
+	OfferType     types.String           `tfsdk:"offer_type"`
+	Price         listingsItemMoneyModel `tfsdk:"price"`
+}
+
+type listingsItemFulfillmentQuantityModel struct {
+	FulfillmentChannelCode types.String `tfsdk:"fulfillment_channel_code"`
+	Quantity               types.Int64  `tfsdk:"quantity"`
+}
+
+type listingsItemProcurementModel struct {
+	CostPrice listingsItemMoneyModel `tfsdk:"cost_price"`
+}
+
+func newListingsItemMoneyModel(money listingsItemMoney) listingsItemMoneyModel {
+	return listingsItemMoneyModel{
+		CurrencyCode: types.StringValue(money.CurrencyCode),
+		Amount:       types.StringValue(money.Amount.String()),
+	}
+}
+
+func newListingsItemSummaryModels(summaries []listingsItemSummary) []listingsItemSummaryModel {
+	models := []listingsItemSummaryModel{}
+
+	for _, summary := range summaries {
+		model := listingsItemSummaryModel{
+			MarketplaceID:   types.StringValue(summary.MarketplaceID),
+			ASIN:            types.StringValue(summary.ASIN),
+			ProductType:     types.StringValue(summary.ProductType),
+			ConditionType:   types.StringValue(summary.ConditionType),
+			Status:          []types.String{},
+			Buyable:         types.BoolValue(false),
+			Discoverable:    types.BoolValue(false),
+			ItemName:        types.StringValue(summary.ItemName),
+			CreatedDate:     types.StringValue(summary.CreatedDate),
+			LastUpdatedDate: types.StringValue(summary.LastUpdatedDate),
+		}
+
+		for _, status := range summary.Status {
+			model.Status = append(model.Status, types.StringValue(status))
+
+			switch status {
+			case "BUYABLE":
+				model.Buyable = types.BoolValue(true)
+			case "DISCOVERABLE":
+				model.Discoverable = types.BoolValue(true)
+			}
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
+
+func listingsItemMoneySchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"currency_code": schema.StringAttribute{
+			Computed: true,
+		},
+		"amount": schema.StringAttribute{
+			Computed:    true,
+			Description: "Decimal amount, kept as a string to preserve precision.",
+		},
+	}
+}
+
+func listingsItemSummarySchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"marketplace_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"asin": schema.StringAttribute{
+			Computed: true,
+		},
+		"product_type": schema.StringAttribute{
+			Computed: true,
+		},
+		"condition_type": schema.StringAttribute{
+			Computed: true,
+		},
+		"status": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"buyable": schema.BoolAttribute{
+			Computed: true,
+		},
+		"discoverable": schema.BoolAttribute{
+			Computed: true,
+		},
+		"item_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"created_date": schema.StringAttribute{
+			Computed: true,
+		},
+		"last_updated_date": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func (d *listingsItemDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *listingsItemDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_listings_item"
+}
+
+func (d *listingsItemDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"seller_id": schema.StringAttribute{
+				Required: true,
+			},
+			"sku": schema.StringAttribute{
+				Required: true,
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"included_data": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Data sets to include: " + strings.Join(listingsItemIncludedData, ", ") + ". " +
+					"Defaults to all but procurement.",
+			},
+			"summaries": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listingsItemSummarySchemaAttributes(),
+				},
+			},
+			"attributes": schema.StringAttribute{
+				Computed:   true,
+				CustomType: listingAttributesType{},
+			},
+			"issues": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listingIssueSchemaAttributes(),
+				},
+			},
+			"offers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"marketplace_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"offer_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"price": schema.SingleNestedAttribute{
+							Computed:   true,
+							Attributes: listingsItemMoneySchemaAttributes(),
+						},
+					},
+				},
+			},
+			"fulfillment_availability": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fulfillment_channel_code": schema.StringAttribute{
+							Computed: true,
+						},
+						"quantity": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"procurement": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cost_price": schema.SingleNestedAttribute{
+							Computed:   true,
+							Attributes: listingsItemMoneySchemaAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *listingsItemDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state listingsItemDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	marketplaceIDs := make([]string, 0, len(state.MarketplaceIDs))
+	for _, id := range state.MarketplaceIDs {
+		marketplaceIDs = append(marketplaceIDs, id.ValueString())
+	}
+
+	includedData := defaultListingsItemIncludedData
+	if state.IncludedData != nil {
+		includedData = make([]string, 0, len(state.IncludedData))
+
+		for _, data := range state.IncludedData {
+			if !slices.Contains(listingsItemIncludedData, data.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("included_data"),
+					"Invalid included data",
+					fmt.Sprintf("%q is not one of %s.", data.ValueString(), strings.Join(listingsItemIncludedData, ", ")),
+				)
+				continue
+			}
+
+			includedData = append(includedData, data.ValueString())
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	client := newListingsItemsClient(d.sellingPartner, d.region)
+
+	item, err := client.GetListingsItem(ctx, state.SellerID.ValueString(), state.SKU.ValueString(), marketplaceIDs, includedData)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listings item", err.Error())
+		return
+	}
+
+	state.IncludedData = []types.String{}
+	for _, data := range includedData {
+		state.IncludedData = append(state.IncludedData, types.StringValue(data))
+	}
+
+	state.Summaries = newListingsItemSummaryModels(item.Summaries)
+	state.Issues = newListingIssueModels(item.Issues)
+
+	state.Attributes = listingAttributesValue{StringValue: types.StringNull()}
+	if item.Attributes != nil {
+		attributes, err := encodeListingAttributes(item.Attributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding listing attributes", err.Error())
+			return
+		}
+
+		state.Attributes = newListingAttributesValue(attributes)
+	}
+
+	state.Offers = []listingsItemOfferModel{}
+	for _, offer := range item.Offers {
+		state.Offers = append(state.Offers, listingsItemOfferModel{
+			MarketplaceID: types.StringValue(offer.MarketplaceID),
+			OfferType:     types.StringValue(offer.OfferType),
+			Price:         newListingsItemMoneyModel(offer.Price),
+		})
+	}
+
+	state.FulfillmentAvailability = []listingsItemFulfillmentQuantityModel{}
+	for _, availability := range item.FulfillmentAvailability {
+		state.FulfillmentAvailability = append(state.FulfillmentAvailability, listingsItemFulfillmentQuantityModel{
+			FulfillmentChannelCode: types.StringValue(availability.FulfillmentChannelCode),
+			Quantity:               types.Int64PointerValue(availability.Quantity),
+		})
+	}
+
+	state.Procurement = []listingsItemProcurementModel{}
+	for _, procurement := range item.Procurement {
+		state.Procurement = append(state.Procurement, listingsItemProcurementModel{
+			CostPrice: newListingsItemMoneyModel(procurement.CostPrice),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
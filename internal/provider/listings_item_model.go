@@ -0,0 +1,59 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type listingsItemModel struct {
+	ID             types.String           `tfsdk:"id"`
+	SellerID       types.String           `tfsdk:"seller_id"`
+	SKU            types.String           `tfsdk:"sku"`
+	ProductType    types.String           `tfsdk:"product_type"`
+	MarketplaceIDs []types.String         `tfsdk:"marketplace_ids"`
+	Requirements   types.String           `tfsdk:"requirements"`
+	Attributes     listingAttributesValue `tfsdk:"attributes"`
+	Status         types.String           `tfsdk:"status"`
+	SubmissionID   types.String           `tfsdk:"submission_id"`
+	ValidateOnPlan types.Bool             `tfsdk:"validate_on_plan"`
+}
+
+func (m listingsItemModel) marketplaceIDs() []string {
+	ids := make([]string, 0, len(m.MarketplaceIDs))
+	for _, id := range m.MarketplaceIDs {
+		ids = append(ids, id.ValueString())
+	}
+
+	return ids
+}
+
+type listingIssueModel struct {
+	Code           types.String   `tfsdk:"code"`
+	Message        types.String   `tfsdk:"message"`
+	Severity       types.String   `tfsdk:"severity"`
+	AttributeNames []types.String `tfsdk:"attribute_names"`
+	Categories     []types.String `tfsdk:"categories"`
+}
+
+func newListingIssueModels(issues []listingIssue) []listingIssueModel {
+	models := []listingIssueModel{}
+
+	for _, issue := range issues {
+		model := listingIssueModel{
+			Code:           types.StringValue(issue.Code),
+			Message:        types.StringValue(issue.Message),
+			Severity:       types.StringValue(issue.Severity),
+			AttributeNames: []types.String{},
+			Categories:     []types.String{},
+		}
+
+		for _, name := range issue.AttributeNames {
+			model.AttributeNames = append(model.AttributeNames, types.StringValue(name))
+		}
+
+		for _, category := range issue.Categories {
+			model.Categories = append(model.Categories, types.StringValue(category))
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listingsItemOfferResourceModel struct {
+	ID                        types.String                             `tfsdk:"id"`
+	SellerID                  types.String                             `tfsdk:"seller_id"`
+	SKU                       types.String                             `tfsdk:"sku"`
+	MarketplaceID             types.String                             `tfsdk:"marketplace_id"`
+	ProductType               types.String                             `tfsdk:"product_type"`
+	Currency                  types.String                             `tfsdk:"currency"`
+	OurPrice                  types.Float64                            `tfsdk:"our_price"`
+	MinimumSellerAllowedPrice types.Float64                            `tfsdk:"minimum_seller_allowed_price"`
+	MaximumSellerAllowedPrice types.Float64                            `tfsdk:"maximum_seller_allowed_price"`
+	BusinessPrice             types.Float64                            `tfsdk:"business_price"`
+	QuantityDiscountType      types.String                             `tfsdk:"quantity_discount_type"`
+	QuantityDiscounts         []listingsItemOfferQuantityDiscountModel `tfsdk:"quantity_discounts"`
+	FulfillmentChannelCode    types.String                             `tfsdk:"fulfillment_channel_code"`
+	Quantity                  types.Int64                              `tfsdk:"quantity"`
+	Status                    types.String                             `tfsdk:"status"`
+	SubmissionID              types.String                             `tfsdk:"submission_id"`
+}
+
+type listingsItemOfferQuantityDiscountModel struct {
+	LowerBound types.Int64   `tfsdk:"lower_bound"`
+	Value      types.Float64 `tfsdk:"value"`
+}
+
+// The listing attributes below mirror the purchasable_offer and
+// fulfillment_availability attributes of the product type definitions.
+
+type listingOfferSchedule struct {
+	Schedule []listingOfferScheduleValue `json:"schedule"`
+}
+
+type listingOfferScheduleValue struct {
+	ValueWithTax json.Number `json:"value_with_tax"`
+}
+
+type listingQuantityDiscountPlan struct {
+	Schedule []listingQuantityDiscountSchedule `json:"schedule"`
+}
+
+type listingQuantityDiscountSchedule struct {
+	DiscountType string                         `json:"discount_type"`
+	Levels       []listingQuantityDiscountLevel `json:"levels"`
+}
+
+type listingQuantityDiscountLevel struct {
+	LowerBound int64       `json:"lower_bound"`
+	Value      json.Number `json:"value"`
+}
+
+type listingPurchasableOffer struct {
+	MarketplaceID             string                        `json:"marketplace_id"`
+	Currency                  string                        `json:"currency"`
+	Audience                  string                        `json:"audience"`
+	OurPrice                  []listingOfferSchedule        `json:"our_price,omitempty"`
+	MinimumSellerAllowedPrice []listingOfferSchedule        `json:"minimum_seller_allowed_price,omitempty"`
+	MaximumSellerAllowedPrice []listingOfferSchedule        `json:"maximum_seller_allowed_price,omitempty"`
+	QuantityDiscountPlan      []listingQuantityDiscountPlan `json:"quantity_discount_plan,omitempty"`
+}
+
+type listingFulfillmentAvailability struct {
+	FulfillmentChannelCode string `json:"fulfillment_channel_code"`
+	Quantity               *int64 `json:"quantity,omitempty"`
+}
+
+func listingPrice(value types.Float64) []listingOfferSchedule {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return []listingOfferSchedule{{
+		Schedule: []listingOfferScheduleValue{{ValueWithTax: listingNumber(value.ValueFloat64())}},
+	}}
+}
+
+func listingNumber(value float64) json.Number {
+	return json.Number(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// listingPriceValue returns the first scheduled price, if any.
+func listingPriceValue(prices []listingOfferSchedule) (float64, bool) {
+	for _, price := range prices {
+		for _, schedule := range price.Schedule {
+			if value, err := schedule.ValueWithTax.Float64(); err == nil {
+				return value, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// purchasableOffers returns the purchasable_offer attribute value for the
+// configured prices: the ALL audience offer and, with a business price, the
+// B2B audience offer.
+func (m listingsItemOfferResourceModel) purchasableOffers() []listingPurchasableOffer {
+	offers := []listingPurchasableOffer{{
+		MarketplaceID:             m.MarketplaceID.ValueString(),
+		Currency:                  m.Currency.ValueString(),
+		Audience:                  "ALL",
+		OurPrice:                  listingPrice(m.OurPrice),
+		MinimumSellerAllowedPrice: listingPrice(m.MinimumSellerAllowedPrice),
+		MaximumSellerAllowedPrice: listingPrice(m.MaximumSellerAllowedPrice),
+	}}
+
+	if m.BusinessPrice.IsNull() {
+		return offers
+	}
+
+	business := listingPurchasableOffer{
+		MarketplaceID: m.MarketplaceID.ValueString(),
+		Currency:      m.Currency.ValueString(),
+		Audience:      "B2B",
+		OurPrice:      listingPrice(m.BusinessPrice),
+	}
+
+	if len(m.QuantityDiscounts) > 0 {
+		schedule := listingQuantityDiscountSchedule{DiscountType: m.QuantityDiscountType.ValueString()}
+		for _, discount := range m.QuantityDiscounts {
+			schedule.Levels = append(schedule.Levels, listingQuantityDiscountLevel{
+				LowerBound: discount.LowerBound.ValueInt64(),
+				Value:      listingNumber(discount.Value.ValueFloat64()),
+			})
+		}
+
+		business.QuantityDiscountPlan = []listingQuantityDiscountPlan{{Schedule: []listingQuantityDiscountSchedule{schedule}}}
+	}
+
+	return append(offers, business)
+}
+
+// patches returns the patch operations that set the owned attributes. prior
+// is the previous state, or nil for a new offer. Offers and fields that were
+// configured in prior but no longer are deleted explicitly, since replacing
+// purchasable_offer only affects the audiences it contains. The fulfillment
+// availability is only patched when a quantity is configured, and merged so
+// that other fulfillment channels are kept.
+func (m listingsItemOfferResourceModel) patches(prior *listingsItemOfferResourceModel) ([]listingsPatchOperation, error) {
+	patches := []listingsPatchOperation{}
+
+	if prior != nil {
+		deletions := prior.removedOfferValues(m)
+		if len(deletions) > 0 {
+			value, err := json.Marshal(deletions)
+			if err != nil {
+				return nil, err
+			}
+
+			patches = append(patches, listingsPatchOperation{Op: "delete", Path: "/attributes/purchasable_offer", Value: value})
+		}
+
+		if !prior.Quantity.IsNull() && (m.Quantity.IsNull() || !m.FulfillmentChannelCode.Equal(prior.FulfillmentChannelCode)) {
+			value, err := json.Marshal([]listingFulfillmentAvailability{{FulfillmentChannelCode: prior.FulfillmentChannelCode.ValueString()}})
+			if err != nil {
+				return nil, err
+			}
+
+			patches = append(patches, listingsPatchOperation{Op: "delete", Path: "/attributes/fulfillment_availability", Value: value})
+		}
+	}
+
+	offers, err := json.Marshal(m.purchasableOffers())
+	if err != nil {
+		return nil, err
+	}
+
+	patches = append(patches, listingsPatchOperation{Op: "replace", Path: "/attributes/purchasable_offer", Value: offers})
+
+	if !m.Quantity.IsNull() {
+		quantity := m.Quantity.ValueInt64()
+
+		availability, err := json.Marshal([]listingFulfillmentAvailability{{
+			FulfillmentChannelCode: m.FulfillmentChannelCode.ValueString(),
+			Quantity:               &quantity,
+		}})
+		if err != nil {
+			return nil, err
+		}
+
+		patches = append(patches, listingsPatchOperation{Op: "merge", Path: "/attributes/fulfillment_availability", Value: availability})
+	}
+
+	return patches, nil
+}
+
+// removedOfferValues returns the purchasable_offer values of m that are no
+// longer configured in next: the whole B2B offer when the business price is
+// removed, and otherwise each removed price or quantity discount plan along
+// with the selectors of its offer.
+func (m listingsItemOfferResourceModel) removedOfferValues(next listingsItemOfferResourceModel) []listingPurchasableOffer {
+	removed := []listingPurchasableOffer{}
+
+	selector := func(audience string) listingPurchasableOffer {
+		return listingPurchasableOffer{
+			MarketplaceID: m.MarketplaceID.ValueString(),
+			Currency:      m.Currency.ValueString(),
+			Audience:      audience,
+		}
+	}
+
+	isRemoved := func(prior types.Float64, value types.Float64) bool {
+		return !prior.IsNull() && value.IsNull()
+	}
+
+	all := selector("ALL")
+	if isRemoved(m.MinimumSellerAllowedPrice, next.MinimumSellerAllowedPrice) {
+		all.MinimumSellerAllowedPrice = listingPrice(m.MinimumSellerAllowedPrice)
+	}
+	if isRemoved(m.MaximumSellerAllowedPrice, next.MaximumSellerAllowedPrice) {
+		all.MaximumSellerAllowedPrice = listingPrice(m.MaximumSellerAllowedPrice)
+	}
+	if all.MinimumSellerAllowedPrice != nil || all.MaximumSellerAllowedPrice != nil {
+		removed = append(removed, all)
+	}
+
+	if m.BusinessPrice.IsNull() {
+		return removed
+	}
+
+	if next.BusinessPrice.IsNull() {
+		return append(removed, selector("B2B"))
+	}
+
+	if len(m.QuantityDiscounts) > 0 && len(next.QuantityDiscounts) == 0 {
+		business := selector("B2B")
+		business.QuantityDiscountPlan = m.purchasableOffers()[1].QuantityDiscountPlan
+		removed = append(removed, business)
+	}
+
+	return removed
+}
+
+// refresh updates the owned fields from the attributes of the listings item.
+// The offers of the marketplace are owned as a whole, so prices added outside
+// of Terraform are reported as drift and deleted on the next apply. Only the
+// quantity is left unmanaged when it is not configured.
+func (m *listingsItemOfferResourceModel) refresh(attributes map[string]json.RawMessage) error {
+	var offers []listingPurchasableOffer
+	if raw, ok := attributes["purchasable_offer"]; ok {
+		if err := json.Unmarshal(raw, &offers); err != nil {
+			return err
+		}
+	}
+
+	refreshPrice := func(field *types.Float64, prices []listingOfferSchedule) {
+		if value, ok := listingPriceValue(prices); ok {
+			*field = types.Float64Value(value)
+		} else {
+			*field = types.Float64Null()
+		}
+	}
+
+	var all, business *listingPurchasableOffer
+	for i, offer := range offers {
+		if offer.MarketplaceID != "" && offer.MarketplaceID != m.MarketplaceID.ValueString() {
+			continue
+		}
+
+		switch offer.Audience {
+		case "", "ALL":
+			all = &offers[i]
+		case "B2B":
+			business = &offers[i]
+		}
+	}
+
+	if all == nil {
+		all = &listingPurchasableOffer{}
+	} else if all.Currency != "" {
+		m.Currency = types.StringValue(all.Currency)
+	}
+
+	refreshPrice(&m.OurPrice, all.OurPrice)
+	refreshPrice(&m.MinimumSellerAllowedPrice, all.MinimumSellerAllowedPrice)
+	refreshPrice(&m.MaximumSellerAllowedPrice, all.MaximumSellerAllowedPrice)
+
+	if business == nil {
+		business = &listingPurchasableOffer{}
+	}
+
+	refreshPrice(&m.BusinessPrice, business.OurPrice)
+
+	// An unset list stays null rather than becoming empty, which Terraform
+	// would report as a change.
+	m.QuantityDiscounts = nil
+
+	for _, plan := range business.QuantityDiscountPlan {
+		for _, schedule := range plan.Schedule {
+			m.QuantityDiscountType = types.StringValue(schedule.DiscountType)
+
+			for _, level := range schedule.Levels {
+				value, err := level.Value.Float64()
+				if err != nil {
+					return err
+				}
+
+				m.QuantityDiscounts = append(m.QuantityDiscounts, listingsItemOfferQuantityDiscountModel{
+					LowerBound: types.Int64Value(level.LowerBound),
+					Value:      types.Float64Value(value),
+				})
+			}
+		}
+	}
+
+	if !m.Quantity.IsNull() {
+		var availability []listingFulfillmentAvailability
+		if raw, ok := attributes["fulfillment_availability"]; ok {
+			if err := json.Unmarshal(raw, &availability); err != nil {
+				return err
+			}
+		}
+
+		m.Quantity = types.Int64Null()
+		for _, entry := range availability {
+			if entry.FulfillmentChannelCode == m.FulfillmentChannelCode.ValueString() && entry.Quantity != nil {
+				m.Quantity = types.Int64Value(*entry.Quantity)
+			}
+		}
+	}
+
+	return nil
+}
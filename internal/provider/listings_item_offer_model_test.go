@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testListingsItemOfferModel() listingsItemOfferResourceModel {
+	return listingsItemOfferResourceModel{
+		MarketplaceID:             types.StringValue("ATVPDKIKX0DER"),
+		Currency:                  types.StringValue("USD"),
+		OurPrice:                  types.Float64Value(19.99),
+		MinimumSellerAllowedPrice: types.Float64Value(10),
+		MaximumSellerAllowedPrice: types.Float64Null(),
+		BusinessPrice:             types.Float64Value(18.5),
+		QuantityDiscountType:      types.StringValue("fixed"),
+		QuantityDiscounts: []listingsItemOfferQuantityDiscountModel{
+			{LowerBound: types.Int64Value(5), Value: types.Float64Value(17)},
+		},
+		FulfillmentChannelCode: types.StringValue("DEFAULT"),
+		Quantity:               types.Int64Value(3),
+	}
+}
+
+func TestListingsItemOfferPatches(t *testing.T) {
+	patches, err := testListingsItemOfferModel().patches(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		op, path, value string
+	}{
+		{
+			op:   "replace",
+			path: "/attributes/purchasable_offer",
+			value: `[
+				{"marketplace_id": "ATVPDKIKX0DER", "currency": "USD", "audience": "ALL",
+				 "our_price": [{"schedule": [{"value_with_tax": 19.99}]}],
+				 "minimum_seller_allowed_price": [{"schedule": [{"value_with_tax": 10}]}]},
+				{"marketplace_id": "ATVPDKIKX0DER", "currency": "USD", "audience": "B2B",
+				 "our_price": [{"schedule": [{"value_with_tax": 18.5}]}],
+				 "quantity_discount_plan": [{"schedule": [{"discount_type": "fixed", "levels": [{"lower_bound": 5, "value": 17}]}]}]}
+			]`,
+		},
+		{
+			op:    "merge",
+			path:  "/attributes/fulfillment_availability",
+			value: `[{"fulfillment_channel_code": "DEFAULT", "quantity": 3}]`,
+		},
+	}
+
+	if len(patches) != len(want) {
+		t.Fatalf("got %d patches, want %d", len(patches), len(want))
+	}
+
+	for i, w := range want {
+		if patches[i].Op != w.op || patches[i].Path != w.path || !listingAttributeValuesEqual(patches[i].Value, json.RawMessage(w.value), nil) {
+			t.Errorf("patch %d = %s %s %s, want %s %s %s", i, patches[i].Op, patches[i].Path, patches[i].Value, w.op, w.path, w.value)
+		}
+	}
+}
+
+func TestListingsItemOfferPatchesRemovals(t *testing.T) {
+	prior := testListingsItemOfferModel()
+
+	tests := map[string]struct {
+		update func(m *listingsItemOfferResourceModel)
+		want   []listingsPatchOperation
+	}{
+		"business price": {
+			update: func(m *listingsItemOfferResourceModel) {
+				m.BusinessPrice = types.Float64Null()
+				m.QuantityDiscounts = nil
+			},
+			want: []listingsPatchOperation{{
+				Op:    "delete",
+				Path:  "/attributes/purchasable_offer",
+				Value: json.RawMessage(`[{"marketplace_id": "ATVPDKIKX0DER", "currency": "USD", "audience": "B2B"}]`),
+			}},
+		},
+		"quantity discounts and minimum price": {
+			update: func(m *listingsItemOfferResourceModel) {
+				m.MinimumSellerAllowedPrice = types.Float64Null()
+				m.QuantityDiscounts = nil
+			},
+			want: []listingsPatchOperation{{
+				Op:   "delete",
+				Path: "/attributes/purchasable_offer",
+				Value: json.RawMessage(`[
+					{"marketplace_id": "ATVPDKIKX0DER", "currency": "USD", "audience": "ALL",
+					 "minimum_seller_allowed_price": [{"schedule": [{"value_with_tax": 10}]}]},
+					{"marketplace_id": "ATVPDKIKX0DER", "currency": "USD", "audience": "B2B",
+					 "quantity_discount_plan": [{"schedule": [{"discount_type": "fixed", "levels": [{"lower_bound": 5, "value": 17}]}]}]}
+				]`),
+			}},
+		},
+		"quantity": {
+			update: func(m *listingsItemOfferResourceModel) {
+				m.Quantity = types.Int64Null()
+			},
+			want: []listingsPatchOperation{{
+				Op:    "delete",
+				Path:  "/attributes/fulfillment_availability",
+				Value: json.RawMessage(`[{"fulfillment_channel_code": "DEFAULT"}]`),
+			}},
+		},
+		"fulfillment channel": {
+			update: func(m *listingsItemOfferResourceModel) {
+				m.FulfillmentChannelCode = types.StringValue("AMAZON_NA")
+			},
+			want: []listingsPatchOperation{{
+				Op:    "delete",
+				Path:  "/attributes/fulfillment_availability",
+				Value: json.RawMessage(`[{"fulfillment_channel_code": "DEFAULT"}]`),
+			}},
+		},
+		"nothing": {
+			update: func(m *listingsItemOfferResourceModel) {
+				m.OurPrice = types.Float64Value(17.99)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := testListingsItemOfferModel()
+			test.update(&plan)
+
+			patches, err := plan.patches(&prior)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var deletes []listingsPatchOperation
+			for _, patch := range patches {
+				if patch.Op == "delete" {
+					deletes = append(deletes, patch)
+				}
+			}
+
+			if len(deletes) != len(test.want) {
+				t.Fatalf("got %d delete patches, want %d: %+v", len(deletes), len(test.want), deletes)
+			}
+
+			for i, want := range test.want {
+				if deletes[i].Path != want.Path || !listingAttributeValuesEqual(deletes[i].Value, want.Value, nil) {
+					t.Errorf("delete %d = %s %s, want %s %s", i, deletes[i].Path, deletes[i].Value, want.Path, want.Value)
+				}
+			}
+
+			if patches[0].Op != "delete" && len(test.want) > 0 {
+				t.Errorf("deletes should come before the replacements, got %s first", patches[0].Op)
+			}
+		})
+	}
+}
+
+func TestListingsItemOfferRefresh(t *testing.T) {
+	model := testListingsItemOfferModel()
+	model.BusinessPrice = types.Float64Null()
+	model.QuantityDiscounts = nil
+
+	err := model.refresh(map[string]json.RawMessage{
+		"item_name": json.RawMessage(`[{"value": "Mug"}]`),
+		"purchasable_offer": json.RawMessage(`[
+			{"marketplace_id": "A2EUQ1WTGCTBG2", "currency": "CAD", "audience": "ALL", "our_price": [{"schedule": [{"value_with_tax": 25}]}]},
+			{"marketplace_id": "ATVPDKIKX0DER", "currency": "USD", "audience": "ALL",
+			 "our_price": [{"schedule": [{"value_with_tax": 21.49}]}],
+			 "maximum_seller_allowed_price": [{"schedule": [{"value_with_tax": 40}]}]},
+			{"marketplace_id": "ATVPDKIKX0DER", "currency": "USD", "audience": "B2B", "our_price": [{"schedule": [{"value_with_tax": 20}]}]}
+		]`),
+		"fulfillment_availability": json.RawMessage(`[{"fulfillment_channel_code": "DEFAULT", "quantity": 7}]`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := model.OurPrice.ValueFloat64(); got != 21.49 {
+		t.Errorf("our_price = %v, want 21.49", got)
+	}
+
+	if !model.MinimumSellerAllowedPrice.IsNull() {
+		t.Errorf("minimum_seller_allowed_price = %v, want null after it was removed", model.MinimumSellerAllowedPrice)
+	}
+
+	if got := model.MaximumSellerAllowedPrice.ValueFloat64(); got != 40 {
+		t.Errorf("maximum_seller_allowed_price = %v, want 40 so the unconfigured price shows as drift", got)
+	}
+
+	if got := model.BusinessPrice.ValueFloat64(); got != 20 {
+		t.Errorf("business_price = %v, want 20 so the unconfigured offer shows as drift", got)
+	}
+
+	if model.QuantityDiscounts != nil {
+		t.Errorf("quantity_discounts = %v, want null", model.QuantityDiscounts)
+	}
+
+	if got := model.Quantity.ValueInt64(); got != 7 {
+		t.Errorf("quantity = %v, want 7", got)
+	}
+}
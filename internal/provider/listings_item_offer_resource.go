@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &listingsItemOfferResource{}
+	_ resource.ResourceWithConfigure      = &listingsItemOfferResource{}
+	_ resource.ResourceWithValidateConfig = &listingsItemOfferResource{}
+)
+
+// NewListingsItemOfferResource is a helper function to simplify the provider implementation.
+func NewListingsItemOfferResource() resource.Resource {
+	return &listingsItemOfferResource{}
+}
+
+// listingsItemOfferResource is the resource implementation.
+type listingsItemOfferResource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+// Metadata returns the resource type name.
+func (r *listingsItemOfferResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_listings_item_offer"
+}
+
+func (r *listingsItemOfferResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.sellingPartner = providerData.SellingPartner
+	r.region = providerData.Region
+}
+
+// Schema defines the schema for the resource.
+func (r *listingsItemOfferResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the price and quantity of an existing listings item in one marketplace. Only the " +
+			"purchasable_offer and fulfillment_availability attributes are patched, all other attributes are left " +
+			"to spapi_listings_item or Seller Central. Destroying the resource stops managing the offer without " +
+			"changing it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"seller_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sku": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"marketplace_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Product type of the listings item. Looked up from the item when unset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"currency": schema.StringAttribute{
+				Required:    true,
+				Description: "ISO 4217 currency code of the prices.",
+			},
+			"our_price": schema.Float64Attribute{
+				Required:    true,
+				Description: "Selling price, including tax where applicable.",
+			},
+			"minimum_seller_allowed_price": schema.Float64Attribute{
+				Optional: true,
+			},
+			"maximum_seller_allowed_price": schema.Float64Attribute{
+				Optional: true,
+			},
+			"business_price": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Price for Amazon Business customers. Removing it deletes the B2B offer.",
+			},
+			"quantity_discount_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("fixed"),
+				Description: "How quantity discount values apply: fixed prices or percent off the business price.",
+			},
+			"quantity_discounts": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Amazon Business quantity price tiers. Requires business_price.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"lower_bound": schema.Int64Attribute{
+							Required: true,
+						},
+						"value": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"fulfillment_channel_code": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("DEFAULT"),
+				Description: "Fulfillment channel the quantity applies to. DEFAULT is merchant fulfilled. The quantity of other channels is kept.",
+			},
+			"quantity": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Available quantity. Left unmanaged when unset.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the last submission.",
+			},
+			"submission_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the last submission.",
+			},
+		},
+	}
+}
+
+func (r *listingsItemOfferResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config listingsItemOfferResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(config.QuantityDiscounts) > 0 && config.BusinessPrice.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("quantity_discounts"),
+			"Missing business price",
+			"Quantity discounts apply to the business price, so business_price must be set.",
+		)
+	}
+
+	if !config.QuantityDiscountType.IsNull() && !config.QuantityDiscountType.IsUnknown() {
+		if discountType := config.QuantityDiscountType.ValueString(); discountType != "fixed" && discountType != "percent" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("quantity_discount_type"),
+				"Invalid quantity discount type",
+				fmt.Sprintf("%q is not one of fixed, percent.", discountType),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *listingsItemOfferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan listingsItemOfferResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newListingsItemsClient(r.sellingPartner, r.region)
+
+	if plan.ProductType.IsUnknown() || plan.ProductType.IsNull() {
+		item, err := client.GetListingsItem(ctx, plan.SellerID.ValueString(), plan.SKU.ValueString(), []string{plan.MarketplaceID.ValueString()}, []string{"summaries"})
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting listings item", err.Error())
+			return
+		}
+
+		for _, summary := range item.Summaries {
+			if summary.ProductType != "" {
+				plan.ProductType = types.StringValue(summary.ProductType)
+				break
+			}
+		}
+
+		if plan.ProductType.IsUnknown() || plan.ProductType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("product_type"),
+				"Unknown product type",
+				fmt.Sprintf("The product type of SKU %s could not be looked up, set product_type explicitly.", plan.SKU.ValueString()),
+			)
+			return
+		}
+	}
+
+	submission, err := r.patchOffer(ctx, client, plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating listings item offer", err.Error())
+		return
+	}
+
+	appendListingIssueDiagnostics(&resp.Diagnostics, "Listings item offer issue", submission.Issues)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.SellerID.ValueString() + "/" + plan.SKU.ValueString() + "/" + plan.MarketplaceID.ValueString())
+	plan.Status = types.StringValue(submission.Status)
+	plan.SubmissionID = types.StringValue(submission.SubmissionID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *listingsItemOfferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state listingsItemOfferResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newListingsItemsClient(r.sellingPartner, r.region)
+
+	item, err := client.GetListingsItem(ctx, state.SellerID.ValueString(), state.SKU.ValueString(), []string{state.MarketplaceID.ValueString()}, []string{"attributes"})
+	if isSPAPINotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listings item", err.Error())
+		return
+	}
+
+	if err := state.refresh(item.Attributes); err != nil {
+		resp.Diagnostics.AddError("Error decoding listings item offer", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *listingsItemOfferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state listingsItemOfferResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newListingsItemsClient(r.sellingPartner, r.region)
+
+	submission, err := r.patchOffer(ctx, client, plan, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating listings item offer", err.Error())
+		return
+	}
+
+	appendListingIssueDiagnostics(&resp.Diagnostics, "Listings item offer issue", submission.Issues)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Status = types.StringValue(submission.Status)
+	plan.SubmissionID = types.StringValue(submission.SubmissionID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. The offer itself is
+// left in place, since deleting the price would make the item unbuyable.
+func (r *listingsItemOfferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// patchOffer submits the owned attributes, deleting those that were removed
+// since prior. Amazon matches purchasable_offer entries by marketplace_id and
+// audience, so offers in other marketplaces are not replaced.
+func (r *listingsItemOfferResource) patchOffer(ctx context.Context, client *listingsItemsClient, plan listingsItemOfferResourceModel, prior *listingsItemOfferResourceModel) (*listingsItemSubmissionResponse, error) {
+	patches, err := plan.patches(prior)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.PatchListingsItem(ctx, plan.SellerID.ValueString(), plan.SKU.ValueString(), []string{plan.MarketplaceID.ValueString()}, listingsItemPatchRequest{
+		ProductType: plan.ProductType.ValueString(),
+		Patches:     patches,
+	})
+}
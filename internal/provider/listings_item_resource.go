@@ -0,0 +1,531 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &listingsItemResource{}
+	_ resource.ResourceWithConfigure   = &listingsItemResource{}
+	_ resource.ResourceWithModifyPlan  = &listingsItemResource{}
+	_ resource.ResourceWithImportState = &listingsItemResource{}
+)
+
+// NewListingsItemResource is a helper function to simplify the provider implementation.
+func NewListingsItemResource() resource.Resource {
+	return &listingsItemResource{}
+}
+
+// listingsItemResource is the resource implementation.
+type listingsItemResource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+// Metadata returns the resource type name.
+func (r *listingsItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_listings_item"
+}
+
+func (r *listingsItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.sellingPartner = providerData.SellingPartner
+	r.region = providerData.Region
+}
+
+// Schema defines the schema for the resource.
+func (r *listingsItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"seller_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sku": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_type": schema.StringAttribute{
+				Required: true,
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"requirements": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("LISTING"),
+				Description: "Requirements level of the submission: LISTING, LISTING_PRODUCT_ONLY or LISTING_OFFER_ONLY.",
+			},
+			"attributes": schema.StringAttribute{
+				Required:    true,
+				CustomType:  listingAttributesType{},
+				Description: "Listing attributes as a JSON object, as described by the product type definition.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the last submission.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"submission_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the last submission.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validate_on_plan": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Validate changed listings with the VALIDATION_PREVIEW mode of putListingsItem during plan.",
+			},
+		},
+	}
+}
+
+// ModifyPlan validates new or changed listings with VALIDATION_PREVIEW when
+// validate_on_plan is set, so issues are reported before anything is submitted.
+func (r *listingsItemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// status and submission_id keep their state value unless the submitted
+	// attributes change, which makes a new submission.
+	if !req.State.Raw.IsNull() {
+		for _, name := range []string{"marketplace_ids", "product_type", "requirements", "attributes"} {
+			var planValue, stateValue attr.Value
+
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &stateValue)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if !planValue.Equal(stateValue) {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("submission_id"), types.StringUnknown())...)
+				break
+			}
+		}
+	}
+
+	if r.sellingPartner == nil {
+		return
+	}
+
+	var validateOnPlan types.Bool
+
+	diags := req.Plan.GetAttribute(ctx, path.Root("validate_on_plan"), &validateOnPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !validateOnPlan.ValueBool() {
+		return
+	}
+
+	// Computed attributes are always unknown, so only the submitted ones have
+	// to be known before the listing can be validated.
+	for _, name := range []string{"seller_id", "sku", "marketplace_ids", "product_type", "requirements", "attributes"} {
+		var value attr.Value
+
+		diags = req.Plan.GetAttribute(ctx, path.Root(name), &value)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !isFullyKnown(ctx, value) {
+			return
+		}
+	}
+
+	var plan listingsItemModel
+
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state listingsItemModel
+
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Attributes.Equal(state.Attributes) && plan.ProductType.Equal(state.ProductType) && plan.Requirements.Equal(state.Requirements) {
+			return
+		}
+	}
+
+	client := newListingsItemsClient(r.sellingPartner, r.region)
+
+	_, diags = validateListingPreview(ctx, client, listingValidationRequest{
+		SellerID:       plan.SellerID.ValueString(),
+		SKU:            plan.SKU.ValueString(),
+		MarketplaceIDs: plan.marketplaceIDs(),
+		ProductType:    plan.ProductType.ValueString(),
+		Requirements:   plan.Requirements.ValueString(),
+		Attributes:     plan.Attributes.ValueString(),
+	}, path.Root("attributes"), true)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *listingsItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan listingsItemModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newListingsItemsClient(r.sellingPartner, r.region)
+
+	submission, err := r.putListingsItem(ctx, client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating listings item", err.Error())
+		return
+	}
+
+	appendListingIssueDiagnostics(&resp.Diagnostics, "Listings item issue", submission.Issues)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.SellerID.ValueString() + "/" + plan.SKU.ValueString())
+	plan.Status = types.StringValue(submission.Status)
+	plan.SubmissionID = types.StringValue(submission.SubmissionID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *listingsItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state listingsItemModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newListingsItemsClient(r.sellingPartner, r.region)
+
+	// An item imported without a marketplace ID is looked up in every
+	// marketplace of the region.
+	if len(state.MarketplaceIDs) == 0 {
+		marketplaceIDs, err := findListingsItemMarketplaces(ctx, client, state.SellerID.ValueString(), state.SKU.ValueString(), regionMarketplaceIDs(r.region.Name))
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting listings item", err.Error())
+			return
+		}
+
+		if len(marketplaceIDs) == 0 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		for _, id := range marketplaceIDs {
+			state.MarketplaceIDs = append(state.MarketplaceIDs, types.StringValue(id))
+		}
+	}
+
+	item, err := client.GetListingsItem(ctx, state.SellerID.ValueString(), state.SKU.ValueString(), state.marketplaceIDs(), []string{"summaries", "attributes"})
+	if isSPAPINotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listings item", err.Error())
+		return
+	}
+
+	for _, summary := range item.Summaries {
+		if summary.ProductType != "" {
+			state.ProductType = types.StringValue(summary.ProductType)
+			break
+		}
+	}
+
+	// An imported item has no configured attributes yet, so it takes all of
+	// the attributes Amazon returns.
+	if state.Attributes.IsNull() {
+		attributes, err := encodeListingAttributes(item.Attributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding listing attributes", err.Error())
+			return
+		}
+
+		state.Attributes = newListingAttributesValue(attributes)
+
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	prior, err := decodeListingAttributes(state.Attributes.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding listing attributes", err.Error())
+		return
+	}
+
+	// Amazon returns every attribute of the item, including ones that were
+	// never configured. Only the configured attributes are checked for drift.
+	refreshed := map[string]json.RawMessage{}
+	drifted := false
+	defaults := newListingAttributeDefaults(state.marketplaceIDs())
+
+	for name, priorValue := range prior {
+		value, ok := item.Attributes[name]
+		if !ok {
+			drifted = true
+			continue
+		}
+
+		if listingAttributeValuesEqual(priorValue, value, defaults) {
+			refreshed[name] = priorValue
+		} else {
+			refreshed[name] = value
+			drifted = true
+		}
+	}
+
+	if drifted {
+		attributes, err := encodeListingAttributes(refreshed)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding listing attributes", err.Error())
+			return
+		}
+
+		state.Attributes = newListingAttributesValue(attributes)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *listingsItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state listingsItemModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newListingsItemsClient(r.sellingPartner, r.region)
+
+	var submission *listingsItemSubmissionResponse
+	var err error
+
+	if !plan.ProductType.Equal(state.ProductType) || !plan.Requirements.Equal(state.Requirements) {
+		// A different product type or requirements level needs a full submission.
+		submission, err = r.putListingsItem(ctx, client, plan)
+	} else {
+		submission, err = r.patchListingsItem(ctx, client, state, plan)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating listings item", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Status = state.Status
+	plan.SubmissionID = state.SubmissionID
+
+	if submission != nil {
+		appendListingIssueDiagnostics(&resp.Diagnostics, "Listings item issue", submission.Issues)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Status = types.StringValue(submission.Status)
+		plan.SubmissionID = types.StringValue(submission.SubmissionID)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *listingsItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state listingsItemModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newListingsItemsClient(r.sellingPartner, r.region)
+
+	_, err := client.DeleteListingsItem(ctx, state.SellerID.ValueString(), state.SKU.ValueString(), state.marketplaceIDs())
+	if err != nil && !isSPAPINotFound(err) {
+		resp.Diagnostics.AddError("Error deleting listings item", err.Error())
+		return
+	}
+}
+
+func (r *listingsItemResource) putListingsItem(ctx context.Context, client *listingsItemsClient, plan listingsItemModel) (*listingsItemSubmissionResponse, error) {
+	attributes, err := decodeListingAttributes(plan.Attributes.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return client.PutListingsItem(ctx, plan.SellerID.ValueString(), plan.SKU.ValueString(), plan.marketplaceIDs(), "", listingsItemPutRequest{
+		ProductType:  plan.ProductType.ValueString(),
+		Requirements: plan.Requirements.ValueString(),
+		Attributes:   attributes,
+	})
+}
+
+// patchListingsItem submits the attribute changes between state and plan. It
+// returns a nil submission when there is nothing to patch.
+func (r *listingsItemResource) patchListingsItem(ctx context.Context, client *listingsItemsClient, state listingsItemModel, plan listingsItemModel) (*listingsItemSubmissionResponse, error) {
+	prior, err := decodeListingAttributes(state.Attributes.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := decodeListingAttributes(plan.Attributes.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	patches := listingAttributePatches(prior, planned, newListingAttributeDefaults(plan.marketplaceIDs()))
+	if len(patches) == 0 {
+		return nil, nil
+	}
+
+	return client.PatchListingsItem(ctx, plan.SellerID.ValueString(), plan.SKU.ValueString(), plan.marketplaceIDs(), listingsItemPatchRequest{
+		ProductType: plan.ProductType.ValueString(),
+		Patches:     patches,
+	})
+}
+
+// ImportState imports a listings item by an ID of seller_id/sku or
+// seller_id/sku/marketplace_id. Read fills in the product type and the
+// attributes.
+func (r *listingsItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	sellerID, sku, marketplaceIDs, err := parseListingsItemImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), sellerID+"/"+sku)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("seller_id"), sellerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sku"), sku)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("marketplace_ids"), marketplaceIDs)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("requirements"), "LISTING")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("validate_on_plan"), false)...)
+}
+
+// parseListingsItemImportID splits an import ID of seller_id/sku or
+// seller_id/sku/marketplace_id. SKUs may contain slashes, so a last segment
+// is only taken as the marketplace ID when it is a known marketplace.
+func parseListingsItemImportID(id string) (string, string, []string, error) {
+	sellerID, sku, ok := strings.Cut(id, "/")
+	if !ok || sellerID == "" || sku == "" {
+		return "", "", nil, fmt.Errorf("expected an ID of seller_id/sku or seller_id/sku/marketplace_id, got %q", id)
+	}
+
+	marketplaceIDs := []string{}
+
+	if i := strings.LastIndex(sku, "/"); i > 0 {
+		if _, ok := lookupMarketplaceByID(sku[i+1:]); ok {
+			marketplaceIDs = append(marketplaceIDs, sku[i+1:])
+			sku = sku[:i]
+		}
+	}
+
+	return sellerID, sku, marketplaceIDs, nil
+}
+
+// findListingsItemMarketplaces returns the marketplaces among candidates that
+// have a listings item for sku. Each marketplace is requested on its own, as
+// an item that is missing from one of them is not found at all otherwise.
+func findListingsItemMarketplaces(ctx context.Context, client *listingsItemsClient, sellerID string, sku string, candidates []string) ([]string, error) {
+	found := []string{}
+
+	for _, marketplaceID := range candidates {
+		_, err := client.GetListingsItem(ctx, sellerID, sku, []string{marketplaceID}, []string{"summaries"})
+		if isSPAPINotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("marketplace %s: %w", marketplaceID, err)
+		}
+
+		found = append(found, marketplaceID)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseListingsItemImportID(t *testing.T) {
+	tests := []struct {
+		id             string
+		sellerID       string
+		sku            string
+		marketplaceIDs []string
+	}{
+		{id: "A1SELLER/MUG-001", sellerID: "A1SELLER", sku: "MUG-001", marketplaceIDs: []string{}},
+		{id: "A1SELLER/MUG-001/A1F83G8C2ARO7P", sellerID: "A1SELLER", sku: "MUG-001", marketplaceIDs: []string{"A1F83G8C2ARO7P"}},
+		{id: "A1SELLER/MUG/RED", sellerID: "A1SELLER", sku: "MUG/RED", marketplaceIDs: []string{}},
+		{id: "A1SELLER/MUG/RED/ATVPDKIKX0DER", sellerID: "A1SELLER", sku: "MUG/RED", marketplaceIDs: []string{"ATVPDKIKX0DER"}},
+	}
+
+	for _, tt := range tests {
+		sellerID, sku, marketplaceIDs, err := parseListingsItemImportID(tt.id)
+		if err != nil {
+			t.Errorf("%s: %s", tt.id, err)
+			continue
+		}
+
+		if sellerID != tt.sellerID || sku != tt.sku || !reflect.DeepEqual(marketplaceIDs, tt.marketplaceIDs) {
+			t.Errorf("%s: got %q, %q, %q, want %q, %q, %q", tt.id, sellerID, sku, marketplaceIDs, tt.sellerID, tt.sku, tt.marketplaceIDs)
+		}
+	}
+
+	for _, id := range []string{"", "A1SELLER", "A1SELLER/", "/MUG-001"} {
+		if _, _, _, err := parseListingsItemImportID(id); err == nil {
+			t.Errorf("%q: expected an error", id)
+		}
+	}
+}
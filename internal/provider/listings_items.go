@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// listingsItemsClient calls the Listings Items API (version 2021-08-01), which
+// the SDK does not generate a client for.
+type listingsItemsClient struct {
+	sellingPartner *sp.SellingPartner
+	endpoint       string
+}
+
+func newListingsItemsClient(sellingPartner *sp.SellingPartner, region spapiRegion) *listingsItemsClient {
+	return &listingsItemsClient{
+		sellingPartner: sellingPartner,
+		endpoint:       region.Endpoint,
+	}
+}
+
+type listingsItemPutRequest struct {
+	ProductType  string                     `json:"productType"`
+	Requirements string                     `json:"requirements,omitempty"`
+	Attributes   map[string]json.RawMessage `json:"attributes"`
+}
+
+type listingsItemPatchRequest struct {
+	ProductType string                   `json:"productType"`
+	Patches     []listingsPatchOperation `json:"patches"`
+}
+
+type listingsPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type listingsItemSubmissionResponse struct {
+	SKU          string         `json:"sku"`
+	Status       string         `json:"status"`
+	SubmissionID string         `json:"submissionId"`
+	Issues       []listingIssue `json:"issues"`
+}
+
+type listingIssue struct {
+	Code           string   `json:"code"`
+	Message        string   `json:"message"`
+	Severity       string   `json:"severity"`
+	AttributeNames []string `json:"attributeNames"`
+	Categories     []string `json:"categories"`
+}
+
+type listingsItem struct {
+	SKU                     string                            `json:"sku"`
+	Summaries               []listingsItemSummary             `json:"summaries"`
+	Attributes              map[string]json.RawMessage        `json:"attributes"`
+	Issues                  []listingIssue                    `json:"issues"`
+	Offers                  []listingsItemOffer               `json:"offers"`
+	FulfillmentAvailability []listingsItemFulfillmentQuantity `json:"fulfillmentAvailability"`
+	Procurement             []listingsItemProcurement         `json:"procurement"`
+}
+
+type listingsItemSummary struct {
+	MarketplaceID   string   `json:"marketplaceId"`
+	ASIN            string   `json:"asin"`
+	ProductType     string   `json:"productType"`
+	ConditionType   string   `json:"conditionType"`
+	Status          []string `json:"status"`
+	ItemName        string   `json:"itemName"`
+	CreatedDate     string   `json:"createdDate"`
+	LastUpdatedDate string   `json:"lastUpdatedDate"`
+}
+
+type listingsItemMoney struct {
+	CurrencyCode string      `json:"currencyCode"`
+	Amount       json.Number `json:"amount"`
+}
+
+type listingsItemOffer struct {
+	MarketplaceID string            `json:"marketplaceId"`
+	OfferType     string            `json:"offerType"`
+	Price         listingsItemMoney `json:"price"`
+}
+
+type listingsItemFulfillmentQuantity struct {
+	FulfillmentChannelCode string `json:"fulfillmentChannelCode"`
+	Quantity               *int64 `json:"quantity"`
+}
+
+type listingsItemProcurement struct {
+	CostPrice listingsItemMoney `json:"costPrice"`
+}
+
+func (c *listingsItemsClient) itemURL(sellerID string, sku string) string {
+	return c.endpoint + "/listings/2021-08-01/items/" + url.PathEscape(sellerID) + "/" + url.PathEscape(sku)
+}
+
+func (c *listingsItemsClient) GetListingsItem(ctx context.Context, sellerID string, sku string, marketplaceIDs []string, includedData []string) (*listingsItem, error) {
+	query := url.Values{}
+	query.Set("marketplaceIds", strings.Join(marketplaceIDs, ","))
+	if len(includedData) > 0 {
+		query.Set("includedData", strings.Join(includedData, ","))
+	}
+
+	var item listingsItem
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.itemURL(sellerID, sku), query, nil, &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// PutListingsItem creates or fully replaces a listings item. mode is either
+// empty or VALIDATION_PREVIEW, in which case nothing is submitted.
+func (c *listingsItemsClient) PutListingsItem(ctx context.Context, sellerID string, sku string, marketplaceIDs []string, mode string, body listingsItemPutRequest) (*listingsItemSubmissionResponse, error) {
+	query := url.Values{}
+	query.Set("marketplaceIds", strings.Join(marketplaceIDs, ","))
+	if mode != "" {
+		query.Set("mode", mode)
+	}
+
+	var submission listingsItemSubmissionResponse
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodPut, c.itemURL(sellerID, sku), query, body, &submission); err != nil {
+		return nil, err
+	}
+
+	return &submission, nil
+}
+
+func (c *listingsItemsClient) PatchListingsItem(ctx context.Context, sellerID string, sku string, marketplaceIDs []string, body listingsItemPatchRequest) (*listingsItemSubmissionResponse, error) {
+	query := url.Values{}
+	query.Set("marketplaceIds", strings.Join(marketplaceIDs, ","))
+
+	var submission listingsItemSubmissionResponse
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodPatch, c.itemURL(sellerID, sku), query, body, &submission); err != nil {
+		return nil, err
+	}
+
+	return &submission, nil
+}
+
+func (c *listingsItemsClient) DeleteListingsItem(ctx context.Context, sellerID string, sku string, marketplaceIDs []string) (*listingsItemSubmissionResponse, error) {
+	query := url.Values{}
+	query.Set("marketplaceIds", strings.Join(marketplaceIDs, ","))
+
+	var submission listingsItemSubmissionResponse
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodDelete, c.itemURL(sellerID, sku), query, nil, &submission); err != nil {
+		return nil, err
+	}
+
+	return &submission, nil
+}
+
+// appendListingIssueDiagnostics reports listing issues as diagnostics. Issues
+// with ERROR severity become errors, WARNING issues become warnings and INFO
+// issues are dropped.
+func appendListingIssueDiagnostics(diags *diag.Diagnostics, summary string, issues []listingIssue) {
+	for _, issue := range issues {
+		detail := fmt.Sprintf("%s: %s", issue.Code, issue.Message)
+		if len(issue.AttributeNames) > 0 {
+			detail += fmt.Sprintf(" (attributes: %s)", strings.Join(issue.AttributeNames, ", "))
+		}
+
+		switch issue.Severity {
+		case "ERROR":
+			diags.AddError(summary, detail)
+		case "WARNING":
+			diags.AddWarning(summary, detail)
+		}
+	}
+}
+
+type listingsItemsSearchResponse struct {
+	NumberOfResults int64 `json:"numberOfResults"`
+	Pagination      *struct {
+		NextToken     string `json:"nextToken"`
+		PreviousToken string `json:"previousToken"`
+	} `json:"pagination"`
+	Items []listingsItem `json:"items"`
+}
+
+// SearchListingsItems returns one page of searchListingsItems. query holds
+// the filters, pageToken selects the page and is empty for the first one.
+func (c *listingsItemsClient) SearchListingsItems(ctx context.Context, sellerID string, query url.Values, pageToken string) (*listingsItemsSearchResponse, error) {
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
+	}
+
+	if pageToken != "" {
+		pageQuery.Set("pageToken", pageToken)
+	}
+
+	var result listingsItemsSearchResponse
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/listings/2021-08-01/items/"+url.PathEscape(sellerID), pageQuery, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &listingsItemsDatasource{}
+	_ datasource.DataSourceWithConfigure = &listingsItemsDatasource{}
+)
+
+func NewListingsItemsDatasource() datasource.DataSource {
+	return &listingsItemsDatasource{}
+}
+
+type listingsItemsDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type listingsItemsDataSourceModel struct {
+	SellerID          types.String              `tfsdk:"seller_id"`
+	MarketplaceIDs    []types.String            `tfsdk:"marketplace_ids"`
+	SKUPrefix         types.String              `tfsdk:"sku_prefix"`
+	WithStatus        []types.String            `tfsdk:"with_status"`
+	WithIssueSeverity []types.String            `tfsdk:"with_issue_severity"`
+	LastUpdatedAfter  types.String              `tfsdk:"last_updated_after"`
+	LastUpdatedBefore types.String              `tfsdk:"last_updated_before"`
+	ProductType       types.String              `tfsdk:"product_type"`
+	SKUs              []types.String            `tfsdk:"skus"`
+	Items             []listingsItemsEntryModel `tfsdk:"items"`
+}
+
+type listingsItemsEntryModel struct {
+	SKU       types.String               `tfsdk:"sku"`
+	Summaries []listingsItemSummaryModel `tfsdk:"summaries"`
+}
+
+func (d *listingsItemsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *listingsItemsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_listings_items"
+}
+
+func (d *listingsItemsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the listings items of a seller with searchListingsItems, following all result pages. " +
+			"The items it returns can be imported into spapi_listings_item with an ID of seller_id/sku or seller_id/sku/marketplace_id; " +
+			"without a marketplace ID the item is looked up in every marketplace of the provider's region.",
+		Attributes: map[string]schema.Attribute{
+			"seller_id": schema.StringAttribute{
+				Required: true,
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"sku_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return SKUs starting with this prefix.",
+			},
+			"with_status": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return items with any of these statuses: BUYABLE, DISCOVERABLE.",
+			},
+			"with_issue_severity": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return items with issues of any of these severities: WARNING, ERROR.",
+			},
+			"last_updated_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return items updated after this RFC 3339 timestamp.",
+			},
+			"last_updated_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return items updated before this RFC 3339 timestamp.",
+			},
+			"product_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return items of this product type.",
+			},
+			"skus": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sku": schema.StringAttribute{
+							Computed: true,
+						},
+						"summaries": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: listingsItemSummarySchemaAttributes(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *listingsItemsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state listingsItemsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	query.Set("includedData", "summaries")
+	query.Set("pageSize", "20")
+
+	marketplaceIDs := make([]string, 0, len(state.MarketplaceIDs))
+	for _, id := range state.MarketplaceIDs {
+		marketplaceIDs = append(marketplaceIDs, id.ValueString())
+	}
+	query.Set("marketplaceIds", strings.Join(marketplaceIDs, ","))
+
+	filters := []struct {
+		attribute string
+		parameter string
+		values    []types.String
+		allowed   []string
+	}{
+		{attribute: "with_status", parameter: "withStatus", values: state.WithStatus, allowed: []string{"BUYABLE", "DISCOVERABLE"}},
+		{attribute: "with_issue_severity", parameter: "withIssueSeverity", values: state.WithIssueSeverity, allowed: []string{"WARNING", "ERROR"}},
+	}
+
+	for _, filter := range filters {
+		if len(filter.values) == 0 {
+			continue
+		}
+
+		values := make([]string, 0, len(filter.values))
+		for _, value := range filter.values {
+			if !slices.Contains(filter.allowed, value.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(filter.attribute),
+					"Invalid filter value",
+					fmt.Sprintf("%q is not one of %s.", value.ValueString(), strings.Join(filter.allowed, ", ")),
+				)
+			}
+
+			values = append(values, value.ValueString())
+		}
+
+		query.Set(filter.parameter, strings.Join(values, ","))
+	}
+
+	timestamps := []struct {
+		attribute string
+		parameter string
+		value     types.String
+	}{
+		{attribute: "last_updated_after", parameter: "lastUpdatedAfter", value: state.LastUpdatedAfter},
+		{attribute: "last_updated_before", parameter: "lastUpdatedBefore", value: state.LastUpdatedBefore},
+	}
+
+	for _, timestamp := range timestamps {
+		if timestamp.value.IsNull() {
+			continue
+		}
+
+		if _, err := time.Parse(time.RFC3339, timestamp.value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(timestamp.attribute), "Invalid timestamp", err.Error())
+			continue
+		}
+
+		query.Set(timestamp.parameter, timestamp.value.ValueString())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newListingsItemsClient(d.sellingPartner, d.region)
+
+	state.SKUs = []types.String{}
+	state.Items = []listingsItemsEntryModel{}
+
+	pageToken := ""
+	for {
+		page, err := client.SearchListingsItems(ctx, state.SellerID.ValueString(), query, pageToken)
+		if err != nil {
+			resp.Diagnostics.AddError("Error searching listings items", err.Error())
+			return
+		}
+
+		for _, item := range page.Items {
+			if !listingsItemMatches(item, state.SKUPrefix.ValueString(), state.ProductType.ValueString()) {
+				continue
+			}
+
+			state.SKUs = append(state.SKUs, types.StringValue(item.SKU))
+			state.Items = append(state.Items, listingsItemsEntryModel{
+				SKU:       types.StringValue(item.SKU),
+				Summaries: newListingsItemSummaryModels(item.Summaries),
+			})
+		}
+
+		if page.Pagination == nil || page.Pagination.NextToken == "" {
+			break
+		}
+
+		pageToken = page.Pagination.NextToken
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listingsItemMatches applies the filters searchListingsItems has no
+// parameter for. Empty filters match every item.
+func listingsItemMatches(item listingsItem, skuPrefix string, productType string) bool {
+	if !strings.HasPrefix(item.SKU, skuPrefix) {
+		return false
+	}
+
+	if productType == "" {
+		return true
+	}
+
+	for _, summary := range item.Summaries {
+		if summary.ProductType == productType {
+			return true
+		}
+	}
+
+	return false
+}
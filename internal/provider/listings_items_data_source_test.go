@@ -0,0 +1,27 @@
+package provider
+
+import "testing"
+
+func TestListingsItemMatches(t *testing.T) {
+	item := listingsItem{
+		SKU:       "MUG-RED-01",
+		Summaries: []listingsItemSummary{{ProductType: "DRINKING_CUP"}},
+	}
+
+	tests := []struct {
+		skuPrefix, productType string
+		want                   bool
+	}{
+		{want: true},
+		{skuPrefix: "MUG-", want: true},
+		{skuPrefix: "CUP-", want: false},
+		{productType: "DRINKING_CUP", want: true},
+		{skuPrefix: "MUG-", productType: "SHIRT", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := listingsItemMatches(item, tt.skuPrefix, tt.productType); got != tt.want {
+			t.Errorf("listingsItemMatches(%q, %q) = %v, want %v", tt.skuPrefix, tt.productType, got, tt.want)
+		}
+	}
+}
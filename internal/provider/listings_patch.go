@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// decodeListingAttributes parses a JSON object of listing attributes into its
+// top-level attributes.
+func decodeListingAttributes(attributesJSON string) (map[string]json.RawMessage, error) {
+	attributes := map[string]json.RawMessage{}
+
+	if attributesJSON == "" {
+		return attributes, nil
+	}
+
+	if err := json.Unmarshal([]byte(attributesJSON), &attributes); err != nil {
+		return nil, fmt.Errorf("listing attributes must be a JSON object: %w", err)
+	}
+
+	return attributes, nil
+}
+
+// encodeListingAttributes is the inverse of decodeListingAttributes. Keys are
+// sorted by encoding/json, so the result is stable.
+func encodeListingAttributes(attributes map[string]json.RawMessage) (string, error) {
+	buf, err := json.Marshal(attributes)
+	if err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
+
+// listingAttributePatches computes the JSON patch operations that turn the
+// prior listing attributes into the planned ones. Amazon patches attributes
+// as a whole, so the operations work on top-level attribute names only.
+func listingAttributePatches(prior map[string]json.RawMessage, planned map[string]json.RawMessage, defaults listingAttributeDefaults) []listingsPatchOperation {
+	names := make([]string, 0, len(prior)+len(planned))
+	for name := range prior {
+		names = append(names, name)
+	}
+	for name := range planned {
+		if _, ok := prior[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	patches := []listingsPatchOperation{}
+
+	for _, name := range names {
+		priorValue, inPrior := prior[name]
+		plannedValue, inPlanned := planned[name]
+
+		switch {
+		case inPlanned && !inPrior:
+			patches = append(patches, listingsPatchOperation{Op: "add", Path: "/attributes/" + name, Value: plannedValue})
+		case inPrior && !inPlanned:
+			// Some attributes require the value to select what gets deleted.
+			patches = append(patches, listingsPatchOperation{Op: "delete", Path: "/attributes/" + name, Value: priorValue})
+		case !listingAttributeValuesEqual(priorValue, plannedValue, defaults):
+			patches = append(patches, listingsPatchOperation{Op: "replace", Path: "/attributes/" + name, Value: plannedValue})
+		}
+	}
+
+	return patches
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestListingAttributePatches(t *testing.T) {
+	prior, err := decodeListingAttributes(`{
+		"item_name": [{"value": "Mug", "language_tag": "en_US"}],
+		"color": [{"value": "Red"}],
+		"bullet_point": [{"value": "Dishwasher safe"}]
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	planned, err := decodeListingAttributes(`{
+		"item_name": [{"language_tag": "en_US", "value": "Mug"}],
+		"color": [{"value": "Blue"}],
+		"material": [{"value": "Ceramic"}]
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patches := listingAttributePatches(prior, planned, newListingAttributeDefaults([]string{"ATVPDKIKX0DER"}))
+
+	want := []struct {
+		op, path, value string
+	}{
+		{op: "delete", path: "/attributes/bullet_point", value: `[{"value": "Dishwasher safe"}]`},
+		{op: "replace", path: "/attributes/color", value: `[{"value": "Blue"}]`},
+		{op: "add", path: "/attributes/material", value: `[{"value": "Ceramic"}]`},
+	}
+
+	if len(patches) != len(want) {
+		t.Fatalf("got %d patches, want %d: %+v", len(patches), len(want), patches)
+	}
+
+	for i, w := range want {
+		if patches[i].Op != w.op || patches[i].Path != w.path || !listingAttributeValuesEqual(patches[i].Value, json.RawMessage(w.value), nil) {
+			t.Errorf("patch %d = %s %s %s, want %s %s %s", i, patches[i].Op, patches[i].Path, patches[i].Value, w.op, w.path, w.value)
+		}
+	}
+}
+
+func TestDecodeListingAttributesRejectsNonObjects(t *testing.T) {
+	if _, err := decodeListingAttributes(`[1, 2]`); err == nil {
+		t.Error("expected an error for a JSON array")
+	}
+}
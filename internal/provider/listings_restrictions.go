@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+)
+
+// listingsRestrictionsClient calls the Listings Restrictions API (version
+// 2021-08-01), which the SDK does not generate a client for.
+type listingsRestrictionsClient struct {
+	sellingPartner *sp.SellingPartner
+	endpoint       string
+}
+
+func newListingsRestrictionsClient(sellingPartner *sp.SellingPartner, region spapiRegion) *listingsRestrictionsClient {
+	return &listingsRestrictionsClient{
+		sellingPartner: sellingPartner,
+		endpoint:       region.Endpoint,
+	}
+}
+
+type listingsRestrictionLink struct {
+	Resource string `json:"resource"`
+	Verb     string `json:"verb"`
+	Title    string `json:"title"`
+	Type     string `json:"type"`
+}
+
+type listingsRestrictionReason struct {
+	Message    string                    `json:"message"`
+	ReasonCode string                    `json:"reasonCode"`
+	Links      []listingsRestrictionLink `json:"links"`
+}
+
+type listingsRestriction struct {
+	MarketplaceID string                      `json:"marketplaceId"`
+	ConditionType string                      `json:"conditionType"`
+	Reasons       []listingsRestrictionReason `json:"reasons"`
+}
+
+type listingsRestrictionList struct {
+	Restrictions []listingsRestriction `json:"restrictions"`
+}
+
+// GetListingsRestrictions returns the restrictions for listing an ASIN. query
+// holds the optional conditionType and reasonLocale parameters.
+func (c *listingsRestrictionsClient) GetListingsRestrictions(ctx context.Context, asin string, sellerID string, marketplaceIDs []string, query url.Values) (*listingsRestrictionList, error) {
+	restrictionsQuery := url.Values{}
+	for key, values := range query {
+		restrictionsQuery[key] = values
+	}
+
+	restrictionsQuery.Set("asin", asin)
+	restrictionsQuery.Set("sellerId", sellerID)
+	restrictionsQuery.Set("marketplaceIds", strings.Join(marketplaceIDs, ","))
+
+	var list listingsRestrictionList
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/listings/2021-08-01/restrictions", restrictionsQuery, nil, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
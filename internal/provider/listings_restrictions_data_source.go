@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &listingsRestrictionsDatasource{}
+	_ datasource.DataSourceWithConfigure = &listingsRestrictionsDatasource{}
+)
+
+// listingConditionTypes are the conditionType values of the Listings APIs.
+var listingConditionTypes = []string{
+	"new_new",
+	"new_open_box",
+	"new_oem",
+	"refurbished_refurbished",
+	"used_like_new",
+	"used_very_good",
+	"used_good",
+	"used_acceptable",
+	"collectible_like_new",
+	"collectible_very_good",
+	"collectible_good",
+	"collectible_acceptable",
+	"club_club",
+}
+
+func NewListingsRestrictionsDatasource() datasource.DataSource {
+	return &listingsRestrictionsDatasource{}
+}
+
+type listingsRestrictionsDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type listingsRestrictionsDataSourceModel struct {
+	ASIN             types.String               `tfsdk:"asin"`
+	ConditionType    types.String               `tfsdk:"condition_type"`
+	SellerID         types.String               `tfsdk:"seller_id"`
+	MarketplaceIDs   []types.String             `tfsdk:"marketplace_ids"`
+	ReasonLocale     types.String               `tfsdk:"reason_locale"`
+	FailIfRestricted types.Bool                 `tfsdk:"fail_if_restricted"`
+	Restricted       types.Bool                 `tfsdk:"restricted"`
+	ApprovalLinks    []types.String             `tfsdk:"approval_links"`
+	Restrictions     []listingsRestrictionModel `tfsdk:"restrictions"`
+}
+
+type listingsRestrictionModel struct {
+	MarketplaceID types.String                     `tfsdk:"marketplace_id"`
+	ConditionType types.String                     `tfsdk:"condition_type"`
+	Reasons       []listingsRestrictionReasonModel `tfsdk:"reasons"`
+}
+
+type listingsRestrictionReasonModel struct {
+	ReasonCode types.String                   `tfsdk:"reason_code"`
+	Message    types.String                   `tfsdk:"message"`
+	Links      []listingsRestrictionLinkModel `tfsdk:"links"`
+}
+
+type listingsRestrictionLinkModel struct {
+	Resource types.String `tfsdk:"resource"`
+	Verb     types.String `tfsdk:"verb"`
+	Title    types.String `tfsdk:"title"`
+	Type     types.String `tfsdk:"type"`
+}
+
+func (d *listingsRestrictionsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *listingsRestrictionsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_listings_restrictions"
+}
+
+func (d *listingsRestrictionsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the restrictions for a seller to list an ASIN with getListingsRestrictions.",
+		Attributes: map[string]schema.Attribute{
+			"asin": schema.StringAttribute{
+				Required: true,
+			},
+			"condition_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Condition to check the restrictions for, e.g. new_new or used_like_new. All conditions are checked when unset.",
+			},
+			"seller_id": schema.StringAttribute{
+				Required: true,
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"reason_locale": schema.StringAttribute{
+				Optional:    true,
+				Description: "Locale of the restriction messages, defaults to the primary locale of the marketplace.",
+			},
+			"fail_if_restricted": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Report restrictions as errors, failing the plan. Defaults to false.",
+			},
+			"restricted": schema.BoolAttribute{
+				Computed: true,
+			},
+			"approval_links": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Links of all restriction reasons, typically to request approval in Seller Central.",
+			},
+			"restrictions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"marketplace_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"condition_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"reasons": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"reason_code": schema.StringAttribute{
+										Computed: true,
+									},
+									"message": schema.StringAttribute{
+										Computed: true,
+									},
+									"links": schema.ListNestedAttribute{
+										Computed: true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"resource": schema.StringAttribute{
+													Computed: true,
+												},
+												"verb": schema.StringAttribute{
+													Computed: true,
+												},
+												"title": schema.StringAttribute{
+													Computed: true,
+												},
+												"type": schema.StringAttribute{
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *listingsRestrictionsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state listingsRestrictionsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+
+	if !state.ConditionType.IsNull() {
+		if !slices.Contains(listingConditionTypes, state.ConditionType.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("condition_type"),
+				"Invalid condition type",
+				fmt.Sprintf("%q is not one of %s.", state.ConditionType.ValueString(), strings.Join(listingConditionTypes, ", ")),
+			)
+			return
+		}
+
+		query.Set("conditionType", state.ConditionType.ValueString())
+	}
+
+	if !state.ReasonLocale.IsNull() {
+		query.Set("reasonLocale", state.ReasonLocale.ValueString())
+	}
+
+	marketplaceIDs := make([]string, 0, len(state.MarketplaceIDs))
+	for _, id := range state.MarketplaceIDs {
+		marketplaceIDs = append(marketplaceIDs, id.ValueString())
+	}
+
+	client := newListingsRestrictionsClient(d.sellingPartner, d.region)
+
+	list, err := client.GetListingsRestrictions(ctx, state.ASIN.ValueString(), state.SellerID.ValueString(), marketplaceIDs, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listings restrictions", err.Error())
+		return
+	}
+
+	state.Restricted = types.BoolValue(len(list.Restrictions) > 0)
+	state.ApprovalLinks = []types.String{}
+	state.Restrictions = make([]listingsRestrictionModel, 0, len(list.Restrictions))
+
+	for _, restriction := range list.Restrictions {
+		reasons := make([]listingsRestrictionReasonModel, 0, len(restriction.Reasons))
+
+		for _, reason := range restriction.Reasons {
+			links := make([]listingsRestrictionLinkModel, 0, len(reason.Links))
+			for _, link := range reason.Links {
+				links = append(links, listingsRestrictionLinkModel{
+					Resource: types.StringValue(link.Resource),
+					Verb:     types.StringValue(link.Verb),
+					Title:    types.StringValue(link.Title),
+					Type:     types.StringValue(link.Type),
+				})
+
+				if !slices.Contains(state.ApprovalLinks, types.StringValue(link.Resource)) {
+					state.ApprovalLinks = append(state.ApprovalLinks, types.StringValue(link.Resource))
+				}
+			}
+
+			reasons = append(reasons, listingsRestrictionReasonModel{
+				ReasonCode: types.StringValue(reason.ReasonCode),
+				Message:    types.StringValue(reason.Message),
+				Links:      links,
+			})
+
+			if state.FailIfRestricted.ValueBool() {
+				resp.Diagnostics.AddError(
+					"Listing restricted",
+					fmt.Sprintf("ASIN %s cannot be listed as %s in marketplace %s: %s: %s",
+						state.ASIN.ValueString(), restriction.ConditionType, restriction.MarketplaceID, reason.ReasonCode, reason.Message),
+				)
+			}
+		}
+
+		// Restrictions do not have to give a reason, but still prevent listing.
+		if state.FailIfRestricted.ValueBool() && len(restriction.Reasons) == 0 {
+			resp.Diagnostics.AddError(
+				"Listing restricted",
+				fmt.Sprintf("ASIN %s cannot be listed as %s in marketplace %s. No reason was given.",
+					state.ASIN.ValueString(), restriction.ConditionType, restriction.MarketplaceID),
+			)
+		}
+
+		state.Restrictions = append(state.Restrictions, listingsRestrictionModel{
+			MarketplaceID: types.StringValue(restriction.MarketplaceID),
+			ConditionType: types.StringValue(restriction.ConditionType),
+			Reasons:       reasons,
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
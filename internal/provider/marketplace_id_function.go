@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = marketplaceIDFunction{}
+)
+
+// NewMarketplaceIDFunction is a helper function to simplify the provider implementation.
+func NewMarketplaceIDFunction() function.Function {
+	return marketplaceIDFunction{}
+}
+
+type marketplaceIDFunction struct{}
+
+func (f marketplaceIDFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "marketplace_id"
+}
+
+func (f marketplaceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Look up an Amazon marketplace by country code",
+		MarkdownDescription: "Returns the marketplace ID, SP-API region, endpoint, AWS region, domain name and default " +
+			"currency and language for the marketplace of the given ISO 3166-1 alpha-2 country code.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "country_code",
+				MarkdownDescription: "Country code of the marketplace, e.g. `US` or `DE`. `UK` is accepted as an alias for `GB`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: marketplaceModelAttributeTypes,
+		},
+	}
+}
+
+func (f marketplaceIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var countryCode string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &countryCode))
+	if resp.Error != nil {
+		return
+	}
+
+	m, ok := lookupMarketplaceByCountryCode(countryCode)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
+			"Unknown marketplace country code %q. Supported country codes: %s.",
+			countryCode, strings.Join(supportedCountryCodes(), ", "),
+		))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, newMarketplaceModel(m)))
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/brandedtech/sp-api-sdk/sellers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &marketplaceParticipationsDatasource{}
+	_ datasource.DataSourceWithConfigure = &marketplaceParticipationsDatasource{}
+)
+
+func NewMarketplaceParticipationsDatasource() datasource.DataSource {
+	return &marketplaceParticipationsDatasource{}
+}
+
+type marketplaceParticipationsDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type marketplaceParticipationsDataSourceModel struct {
+	Participations []marketplaceParticipationModel `tfsdk:"participations"`
+}
+
+type marketplaceParticipationModel struct {
+	MarketplaceID        types.String `tfsdk:"marketplace_id"`
+	Name                 types.String `tfsdk:"name"`
+	CountryCode          types.String `tfsdk:"country_code"`
+	DefaultCurrencyCode  types.String `tfsdk:"default_currency_code"`
+	DefaultLanguageCode  types.String `tfsdk:"default_language_code"`
+	DomainName           types.String `tfsdk:"domain_name"`
+	IsParticipating      types.Bool   `tfsdk:"is_participating"`
+	HasSuspendedListings types.Bool   `tfsdk:"has_suspended_listings"`
+}
+
+func (d *marketplaceParticipationsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *marketplaceParticipationsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketplace_participations"
+}
+
+func (d *marketplaceParticipationsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"participations": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"marketplace_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"country_code": schema.StringAttribute{
+							Computed: true,
+						},
+						"default_currency_code": schema.StringAttribute{
+							Computed: true,
+						},
+						"default_language_code": schema.StringAttribute{
+							Computed: true,
+						},
+						"domain_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_participating": schema.BoolAttribute{
+							Computed: true,
+						},
+						"has_suspended_listings": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *marketplaceParticipationsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state marketplaceParticipationsDataSourceModel
+
+	client, err := sellers.NewClientWithResponses(d.region.Endpoint,
+		sellers.WithRequestBefore(func(ctx context.Context, req *http.Request) error {
+			return d.sellingPartner.AuthorizeRequest(req)
+		}),
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating sellers client", err.Error())
+		return
+	}
+
+	participations, err := client.GetMarketplaceParticipationsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting marketplace participations", err.Error())
+		return
+	}
+
+	if participations.Model.Errors != nil {
+		for _, error := range *participations.Model.Errors {
+			resp.Diagnostics.AddError("Error getting marketplace participations", error.Message)
+		}
+		return
+	}
+
+	state.Participations = []marketplaceParticipationModel{}
+
+	if participations.Model.Payload != nil {
+		for _, participation := range *participations.Model.Payload {
+			state.Participations = append(state.Participations, marketplaceParticipationModel{
+				MarketplaceID:        types.StringValue(participation.Marketplace.Id),
+				Name:                 types.StringValue(participation.Marketplace.Name),
+				CountryCode:          types.StringValue(participation.Marketplace.CountryCode),
+				DefaultCurrencyCode:  types.StringValue(participation.Marketplace.DefaultCurrencyCode),
+				DefaultLanguageCode:  types.StringValue(participation.Marketplace.DefaultLanguageCode),
+				DomainName:           types.StringValue(participation.Marketplace.DomainName),
+				IsParticipating:      types.BoolValue(participation.Participation.IsParticipating),
+				HasSuspendedListings: types.BoolValue(participation.Participation.HasSuspendedListings),
+			})
+		}
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
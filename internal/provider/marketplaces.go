@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// spapiRegion describes one of the SP-API regional endpoints and the AWS
+// region that backs it.
+type spapiRegion struct {
+	Name      string
+	Endpoint  string
+	AWSRegion string
+}
+
+var spapiRegions = map[string]spapiRegion{
+	"na": {Name: "na", Endpoint: "https://sellingpartnerapi-na.amazon.com", AWSRegion: "us-east-1"},
+	"eu": {Name: "eu", Endpoint: "https://sellingpartnerapi-eu.amazon.com", AWSRegion: "eu-west-1"},
+	"fe": {Name: "fe", Endpoint: "https://sellingpartnerapi-fe.amazon.com", AWSRegion: "us-west-2"},
+}
+
+// marketplace is an entry of the static marketplace catalog.
+type marketplace struct {
+	ID                  string
+	CountryCode         string
+	Name                string
+	Region              string
+	DefaultCurrencyCode string
+	DefaultLanguageCode string
+	DomainName          string
+}
+
+// marketplaceCatalog lists the marketplaces documented at
+// https://developer-docs.amazon.com/sp-api/docs/marketplace-ids.
+var marketplaceCatalog = []marketplace{
+	{ID: "A2EUQ1WTGCTBG2", CountryCode: "CA", Name: "Canada", Region: "na", DefaultCurrencyCode: "CAD", DefaultLanguageCode: "en_CA", DomainName: "www.amazon.ca"},
+	{ID: "ATVPDKIKX0DER", CountryCode: "US", Name: "United States of America", Region: "na", DefaultCurrencyCode: "USD", DefaultLanguageCode: "en_US", DomainName: "www.amazon.com"},
+	{ID: "A1AM78C64UM0Y8", CountryCode: "MX", Name: "Mexico", Region: "na", DefaultCurrencyCode: "MXN", DefaultLanguageCode: "es_MX", DomainName: "www.amazon.com.mx"},
+	{ID: "A2Q3Y263D00KWC", CountryCode: "BR", Name: "Brazil", Region: "na", DefaultCurrencyCode: "BRL", DefaultLanguageCode: "pt_BR", DomainName: "www.amazon.com.br"},
+	{ID: "A28R8C7NBKEWEA", CountryCode: "IE", Name: "Ireland", Region: "eu", DefaultCurrencyCode: "EUR", DefaultLanguageCode: "en_IE", DomainName: "www.amazon.ie"},
+	{ID: "A1RKKUPIHCS9HS", CountryCode: "ES", Name: "Spain", Region: "eu", DefaultCurrencyCode: "EUR", DefaultLanguageCode: "es_ES", DomainName: "www.amazon.es"},
+	{ID: "A1F83G8C2ARO7P", CountryCode: "GB", Name: "United Kingdom", Region: "eu", DefaultCurrencyCode: "GBP", DefaultLanguageCode: "en_GB", DomainName: "www.amazon.co.uk"},
+	{ID: "A13V1IB3VIYZZH", CountryCode: "FR", Name: "France", Region: "eu", DefaultCurrencyCode: "EUR", DefaultLanguageCode: "fr_FR", DomainName: "www.amazon.fr"},
+	{ID: "AMEN7PMS3EDWL", CountryCode: "BE", Name: "Belgium", Region: "eu", DefaultCurrencyCode: "EUR", DefaultLanguageCode: "fr_BE", DomainName: "www.amazon.com.be"},
+	{ID: "A1805IZSGTT6HS", CountryCode: "NL", Name: "Netherlands", Region: "eu", DefaultCurrencyCode: "EUR", DefaultLanguageCode: "nl_NL", DomainName: "www.amazon.nl"},
+	{ID: "A1PA6795UKMFR9", CountryCode: "DE", Name: "Germany", Region: "eu", DefaultCurrencyCode: "EUR", DefaultLanguageCode: "de_DE", DomainName: "www.amazon.de"},
+	{ID: "APJ6JRA9NG5V4", CountryCode: "IT", Name: "Italy", Region: "eu", DefaultCurrencyCode: "EUR", DefaultLanguageCode: "it_IT", DomainName: "www.amazon.it"},
+	{ID: "A2NODRKZP88ZB9", CountryCode: "SE", Name: "Sweden", Region: "eu", DefaultCurrencyCode: "SEK", DefaultLanguageCode: "sv_SE", DomainName: "www.amazon.se"},
+	{ID: "AE08WJ6YKNBMC", CountryCode: "ZA", Name: "South Africa", Region: "eu", DefaultCurrencyCode: "ZAR", DefaultLanguageCode: "en_ZA", DomainName: "www.amazon.co.za"},
+	{ID: "A1C3SOZRARQ6R3", CountryCode: "PL", Name: "Poland", Region: "eu", DefaultCurrencyCode: "PLN", DefaultLanguageCode: "pl_PL", DomainName: "www.amazon.pl"},
+	{ID: "ARBP9OOSHTCHU", CountryCode: "EG", Name: "Egypt", Region: "eu", DefaultCurrencyCode: "EGP", DefaultLanguageCode: "ar_EG", DomainName: "www.amazon.eg"},
+	{ID: "A33AVAJ2PDY3EV", CountryCode: "TR", Name: "Turkey", Region: "eu", DefaultCurrencyCode: "TRY", DefaultLanguageCode: "tr_TR", DomainName: "www.amazon.com.tr"},
+	{ID: "A17E79C6D8DWNP", CountryCode: "SA", Name: "Saudi Arabia", Region: "eu", DefaultCurrencyCode: "SAR", DefaultLanguageCode: "ar_SA", DomainName: "www.amazon.sa"},
+	{ID: "A2VIGQ35RCS4UG", CountryCode: "AE", Name: "United Arab Emirates", Region: "eu", DefaultCurrencyCode: "AED", DefaultLanguageCode: "en_AE", DomainName: "www.amazon.ae"},
+	{ID: "A21TJRUUN4KGV", CountryCode: "IN", Name: "India", Region: "eu", DefaultCurrencyCode: "INR", DefaultLanguageCode: "en_IN", DomainName: "www.amazon.in"},
+	{ID: "A19VAU5U5O7RUS", CountryCode: "SG", Name: "Singapore", Region: "fe", DefaultCurrencyCode: "SGD", DefaultLanguageCode: "en_SG", DomainName: "www.amazon.sg"},
+	{ID: "A39IBJ37TRP1C6", CountryCode: "AU", Name: "Australia", Region: "fe", DefaultCurrencyCode: "AUD", DefaultLanguageCode: "en_AU", DomainName: "www.amazon.com.au"},
+	{ID: "A1VC38T7YXB528", CountryCode: "JP", Name: "Japan", Region: "fe", DefaultCurrencyCode: "JPY", DefaultLanguageCode: "ja_JP", DomainName: "www.amazon.co.jp"},
+}
+
+// lookupMarketplaceByCountryCode returns the catalog entry for an ISO 3166-1
+// alpha-2 country code. Amazon documents the United Kingdom as "UK", so that
+// code is accepted as an alias for "GB".
+func lookupMarketplaceByCountryCode(countryCode string) (marketplace, bool) {
+	countryCode = strings.ToUpper(strings.TrimSpace(countryCode))
+	if countryCode == "UK" {
+		countryCode = "GB"
+	}
+
+	for _, m := range marketplaceCatalog {
+		if m.CountryCode == countryCode {
+			return m, true
+		}
+	}
+
+	return marketplace{}, false
+}
+
+// lookupMarketplaceByID returns the catalog entry for a marketplace ID.
+func lookupMarketplaceByID(id string) (marketplace, bool) {
+	for _, m := range marketplaceCatalog {
+		if m.ID == id {
+			return m, true
+		}
+	}
+
+	return marketplace{}, false
+}
+
+// regionMarketplaceIDs returns the IDs of the catalog marketplaces of an
+// SP-API region.
+func regionMarketplaceIDs(region string) []string {
+	ids := []string{}
+	for _, m := range marketplaceCatalog {
+		if m.Region == region {
+			ids = append(ids, m.ID)
+		}
+	}
+
+	return ids
+}
+
+// supportedCountryCodes returns the sorted country codes of the catalog.
+func supportedCountryCodes() []string {
+	codes := make([]string, 0, len(marketplaceCatalog))
+	for _, m := range marketplaceCatalog {
+		codes = append(codes, m.CountryCode)
+	}
+
+	sort.Strings(codes)
+
+	return codes
+}
+
+type marketplaceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	CountryCode         types.String `tfsdk:"country_code"`
+	Name                types.String `tfsdk:"name"`
+	Region              types.String `tfsdk:"region"`
+	Endpoint            types.String `tfsdk:"endpoint"`
+	AWSRegion           types.String `tfsdk:"aws_region"`
+	DefaultCurrencyCode types.String `tfsdk:"default_currency_code"`
+	DefaultLanguageCode types.String `tfsdk:"default_language_code"`
+	DomainName          types.String `tfsdk:"domain_name"`
+}
+
+var marketplaceModelAttributeTypes = map[string]attr.Type{
+	"id":                    types.StringType,
+	"country_code":          types.StringType,
+	"name":                  types.StringType,
+	"region":                types.StringType,
+	"endpoint":              types.StringType,
+	"aws_region":            types.StringType,
+	"default_currency_code": types.StringType,
+	"default_language_code": types.StringType,
+	"domain_name":           types.StringType,
+}
+
+func newMarketplaceModel(m marketplace) marketplaceModel {
+	region := spapiRegions[m.Region]
+
+	return marketplaceModel{
+		ID:                  types.StringValue(m.ID),
+		CountryCode:         types.StringValue(m.CountryCode),
+		Name:                types.StringValue(m.Name),
+		Region:              types.StringValue(region.Name),
+		Endpoint:            types.StringValue(region.Endpoint),
+		AWSRegion:           types.StringValue(region.AWSRegion),
+		DefaultCurrencyCode: types.StringValue(m.DefaultCurrencyCode),
+		DefaultLanguageCode: types.StringValue(m.DefaultLanguageCode),
+		DomainName:          types.StringValue(m.DomainName),
+	}
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &marketplacesDatasource{}
+)
+
+func NewMarketplacesDatasource() datasource.DataSource {
+	return &marketplacesDatasource{}
+}
+
+// marketplacesDatasource exposes the static marketplace catalog. It does not
+// call the SP-API and therefore needs no provider data.
+type marketplacesDatasource struct{}
+
+type marketplacesDataSourceModel struct {
+	Region       types.String       `tfsdk:"region"`
+	Marketplaces []marketplaceModel `tfsdk:"marketplaces"`
+}
+
+func (d *marketplacesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketplaces"
+}
+
+func (d *marketplacesDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return marketplaces of this SP-API region (na, eu or fe).",
+				Validators: []validator.String{
+					oneOfValidator{values: []string{"na", "eu", "fe"}},
+				},
+			},
+			"marketplaces": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"country_code": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"region": schema.StringAttribute{
+							Computed: true,
+						},
+						"endpoint": schema.StringAttribute{
+							Computed: true,
+						},
+						"aws_region": schema.StringAttribute{
+							Computed: true,
+						},
+						"default_currency_code": schema.StringAttribute{
+							Computed: true,
+						},
+						"default_language_code": schema.StringAttribute{
+							Computed: true,
+						},
+						"domain_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *marketplacesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state marketplacesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Marketplaces = []marketplaceModel{}
+
+	for _, m := range marketplaceCatalog {
+		if !state.Region.IsNull() && state.Region.ValueString() != m.Region {
+			continue
+		}
+
+		state.Marketplaces = append(state.Marketplaces, newMarketplaceModel(m))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import "testing"
+
+func TestLookupMarketplaceByCountryCode(t *testing.T) {
+	tests := []struct {
+		countryCode string
+		wantID      string
+		wantRegion  string
+		wantOK      bool
+	}{
+		{countryCode: "US", wantID: "ATVPDKIKX0DER", wantRegion: "na", wantOK: true},
+		{countryCode: "de", wantID: "A1PA6795UKMFR9", wantRegion: "eu", wantOK: true},
+		{countryCode: "UK", wantID: "A1F83G8C2ARO7P", wantRegion: "eu", wantOK: true},
+		{countryCode: " JP ", wantID: "A1VC38T7YXB528", wantRegion: "fe", wantOK: true},
+		{countryCode: "XX", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		m, ok := lookupMarketplaceByCountryCode(tt.countryCode)
+		if ok != tt.wantOK {
+			t.Fatalf("lookupMarketplaceByCountryCode(%q) ok = %v, want %v", tt.countryCode, ok, tt.wantOK)
+		}
+
+		if m.ID != tt.wantID || m.Region != tt.wantRegion {
+			t.Errorf("lookupMarketplaceByCountryCode(%q) = %s/%s, want %s/%s", tt.countryCode, m.ID, m.Region, tt.wantID, tt.wantRegion)
+		}
+	}
+}
+
+func TestMarketplaceCatalogRegions(t *testing.T) {
+	seen := map[string]bool{}
+
+	for _, m := range marketplaceCatalog {
+		if _, ok := spapiRegions[m.Region]; !ok {
+			t.Errorf("marketplace %s has unknown region %q", m.ID, m.Region)
+		}
+
+		if seen[m.CountryCode] {
+			t.Errorf("duplicate country code %s", m.CountryCode)
+		}
+		seen[m.CountryCode] = true
+	}
+}
@@ -1,6 +1,21 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brandedtech/sp-api-sdk/notifications"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// partnerEventSourcePrefix is the prefix of all partner event sources created
+// by the SP-API for EventBridge destinations.
+const partnerEventSourcePrefix = "aws.partner/sellingpartnerapi.amazon/"
+
+const (
+	eventBridgeAssociationPending = "PENDING"
+	eventBridgeAssociationCreated = "CREATED"
+)
 
 type notificationDestinationModel struct {
 	ID       types.String                         `tfsdk:"id"`
@@ -8,6 +23,16 @@ type notificationDestinationModel struct {
 	Resource notificationDestinationResourceModel `tfsdk:"resource"`
 }
 
+// managedNotificationDestinationModel is the spapi_notification_destination
+// resource model. It adds settings that only affect the resource lifecycle.
+type managedNotificationDestinationModel struct {
+	ID                 types.String                         `tfsdk:"id"`
+	Name               types.String                         `tfsdk:"name"`
+	Resource           notificationDestinationResourceModel `tfsdk:"resource"`
+	WaitForAssociation types.Bool                           `tfsdk:"wait_for_association"`
+	AssociationTimeout types.String                         `tfsdk:"association_timeout"`
+}
+
 type notificationDestinationResourceModel struct {
 	SQS         *notificationDestinationResourceSQSModel         `tfsdk:"sqs"`
 	EventBridge *notificationDestinationResourceEventBridgeModel `tfsdk:"event_bridge"`
@@ -18,7 +43,52 @@ type notificationDestinationResourceSQSModel struct {
 }
 
 type notificationDestinationResourceEventBridgeModel struct {
-	Name      types.String `tfsdk:"name"`
-	Region    types.String `tfsdk:"region"`
-	AccountID types.String `tfsdk:"account_id"`
+	Name                   types.String `tfsdk:"name"`
+	Region                 types.String `tfsdk:"region"`
+	AccountID              types.String `tfsdk:"account_id"`
+	PartnerEventSourceName types.String `tfsdk:"partner_event_source_name"`
+	PartnerEventSourceARN  types.String `tfsdk:"partner_event_source_arn"`
+	AssociationStatus      types.String `tfsdk:"association_status"`
+}
+
+func newNotificationDestinationResourceEventBridgeModel(eventBridge notifications.EventBridgeResource) *notificationDestinationResourceEventBridgeModel {
+	model := &notificationDestinationResourceEventBridgeModel{
+		Name:                   types.StringValue(eventBridge.Name),
+		Region:                 types.StringValue(eventBridge.Region),
+		AccountID:              types.StringValue(eventBridge.AccountId),
+		PartnerEventSourceName: types.StringNull(),
+		PartnerEventSourceARN:  types.StringNull(),
+		AssociationStatus:      types.StringValue(eventBridgeAssociationPending),
+	}
+
+	if eventBridge.Name != "" {
+		name := eventBridge.Name
+		if !strings.HasPrefix(name, partnerEventSourcePrefix) {
+			name = partnerEventSourcePrefix + name
+		}
+
+		model.PartnerEventSourceName = types.StringValue(name)
+		model.PartnerEventSourceARN = types.StringValue(fmt.Sprintf("arn:aws:events:%s::event-source/%s", eventBridge.Region, name))
+		model.AssociationStatus = types.StringValue(eventBridgeAssociationCreated)
+	}
+
+	return model
+}
+
+// setEventBridge refreshes the computed attributes of an EventBridge
+// destination. Region and account ID are kept as configured when there is a
+// prior value, only the partner event source comes from the API.
+func (m *notificationDestinationResourceModel) setEventBridge(eventBridge *notifications.EventBridgeResource) {
+	if eventBridge == nil {
+		eventBridge = &notifications.EventBridgeResource{}
+	}
+
+	model := newNotificationDestinationResourceEventBridgeModel(*eventBridge)
+
+	if m.EventBridge != nil {
+		model.Region = m.EventBridge.Region
+		model.AccountID = m.EventBridge.AccountID
+	}
+
+	m.EventBridge = model
 }
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/brandedtech/sp-api-sdk/notifications"
+)
+
+func TestNewNotificationDestinationResourceEventBridgeModel(t *testing.T) {
+	model := newNotificationDestinationResourceEventBridgeModel(notifications.EventBridgeResource{
+		AccountId: "123456789012",
+		Name:      "sp-api-destination",
+		Region:    "us-east-1",
+	})
+
+	if got, want := model.PartnerEventSourceName.ValueString(), "aws.partner/sellingpartnerapi.amazon/sp-api-destination"; got != want {
+		t.Errorf("partner_event_source_name = %q, want %q", got, want)
+	}
+
+	if got, want := model.PartnerEventSourceARN.ValueString(), "arn:aws:events:us-east-1::event-source/aws.partner/sellingpartnerapi.amazon/sp-api-destination"; got != want {
+		t.Errorf("partner_event_source_arn = %q, want %q", got, want)
+	}
+
+	if got := model.AssociationStatus.ValueString(); got != eventBridgeAssociationCreated {
+		t.Errorf("association_status = %q, want %q", got, eventBridgeAssociationCreated)
+	}
+
+	pending := newNotificationDestinationResourceEventBridgeModel(notifications.EventBridgeResource{Region: "us-east-1"})
+	if !pending.PartnerEventSourceARN.IsNull() || pending.AssociationStatus.ValueString() != eventBridgeAssociationPending {
+		t.Errorf("unexpected model for destination without event source: %+v", pending)
+	}
+}
+
+func TestNotificationDestinationResourceModelSetEventBridge(t *testing.T) {
+	model := notificationDestinationResourceModel{
+		EventBridge: newNotificationDestinationResourceEventBridgeModel(notifications.EventBridgeResource{
+			AccountId: "123456789012",
+			Region:    "us-east-1",
+		}),
+	}
+
+	model.setEventBridge(&notifications.EventBridgeResource{Name: "sp-api-destination"})
+
+	if got := model.EventBridge.AssociationStatus.ValueString(); got != eventBridgeAssociationCreated {
+		t.Errorf("association_status = %q, want %q", got, eventBridgeAssociationCreated)
+	}
+
+	if model.EventBridge.Region.ValueString() != "us-east-1" || model.EventBridge.AccountID.ValueString() != "123456789012" {
+		t.Errorf("region and account ID were not kept: %+v", model.EventBridge)
+	}
+
+	model.setEventBridge(nil)
+
+	if got := model.EventBridge.AssociationStatus.ValueString(); got != eventBridgeAssociationPending {
+		t.Errorf("association_status = %q, want %q", got, eventBridgeAssociationPending)
+	}
+}
@@ -4,18 +4,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/brandedtech/sp-api-sdk/notifications"
-	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &notificationDestinationResource{}
-	_ resource.ResourceWithConfigure = &notificationDestinationResource{}
+	_ resource.Resource               = &notificationDestinationResource{}
+	_ resource.ResourceWithConfigure  = &notificationDestinationResource{}
+	_ resource.ResourceWithModifyPlan = &notificationDestinationResource{}
 )
 
 // NewNotificationDestinationResource is a helper function to simplify the provider implementation.
@@ -25,7 +33,8 @@ func NewNotificationDestinationResource() resource.Resource {
 
 // orderResource is the resource implementation.
 type notificationDestinationResource struct {
-	sellingPartner *sp.SellingPartner
+	grantlessTokens *grantlessTokens
+	region          spapiRegion
 }
 
 // Metadata returns the resource type name.
@@ -38,18 +47,19 @@ func (r *notificationDestinationResource) Configure(_ context.Context, req resou
 		return
 	}
 
-	sellingPartner, ok := req.ProviderData.(*sp.SellingPartner)
+	providerData, ok := req.ProviderData.(*spapiProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sp.SellingPartner, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.sellingPartner = sellingPartner
+	r.grantlessTokens = providerData.GrantlessTokens
+	r.region = providerData.Region
 }
 
 // Schema defines the schema for the resource.
@@ -61,6 +71,9 @@ func (r *notificationDestinationResource) Schema(_ context.Context, _ resource.S
 			},
 			"name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"resource": schema.SingleNestedAttribute{
 				Required: true,
@@ -70,6 +83,12 @@ func (r *notificationDestinationResource) Schema(_ context.Context, _ resource.S
 						Attributes: map[string]schema.Attribute{
 							"arn": schema.StringAttribute{
 								Required: true,
+								Validators: []validator.String{
+									sqsQueueARNValidator{},
+								},
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
 							},
 						},
 					},
@@ -81,21 +100,89 @@ func (r *notificationDestinationResource) Schema(_ context.Context, _ resource.S
 							},
 							"region": schema.StringAttribute{
 								Required: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
 							},
 							"account_id": schema.StringAttribute{
 								Required: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+							},
+							"partner_event_source_name": schema.StringAttribute{
+								Computed:    true,
+								Description: "Full name of the partner event source, for the event_source_name of aws_cloudwatch_event_bus.",
+							},
+							"partner_event_source_arn": schema.StringAttribute{
+								Computed: true,
+							},
+							"association_status": schema.StringAttribute{
+								Computed: true,
+								Description: "PENDING until getDestination reports the partner event source, CREATED afterwards. " +
+									"The SP-API does not report whether an event bus has been associated on the AWS side.",
 							},
 						},
 					},
 				},
 			},
+			"wait_for_association": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Poll getDestination after creating an event_bridge destination until the partner event source is reported.",
+			},
+			"association_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("5m"),
+				Description: "How long to wait for the partner event source when wait_for_association is set, as a Go duration.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
 		},
 	}
 }
 
+// ModifyPlan checks that an SQS queue lives in the AWS region of the
+// provider's SP-API region, as Amazon rejects queues in any other region.
+func (r *notificationDestinationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.grantlessTokens == nil {
+		return
+	}
+
+	var plan managedNotificationDestinationModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Resource.SQS == nil || plan.Resource.SQS.ARN.IsUnknown() {
+		return
+	}
+
+	arn, err := parseSQSQueueARN(plan.Resource.SQS.ARN.ValueString())
+	if err != nil {
+		// Reported by sqsQueueARNValidator.
+		return
+	}
+
+	if arn.Region != r.region.AWSRegion {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resource").AtName("sqs").AtName("arn"),
+			"SQS queue in wrong region",
+			fmt.Sprintf("The SQS queue is in %s, but destinations for the SP-API %s region must use a queue in %s.",
+				arn.Region, r.region.Name, r.region.AWSRegion),
+		)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *notificationDestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan notificationDestinationModel
+	var plan managedNotificationDestinationModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -103,9 +190,9 @@ func (r *notificationDestinationResource) Create(ctx context.Context, req resour
 		return
 	}
 
-	client, err := notifications.NewClientWithResponses("https://sellingpartnerapi-na.amazon.com",
+	client, err := notifications.NewClientWithResponses(r.region.Endpoint,
 		notifications.WithRequestBefore(func(ctx context.Context, req *http.Request) error {
-			return r.sellingPartner.AuthorizeRequestWithScope(req, "sellingpartnerapi::notifications")
+			return r.grantlessTokens.authorizer("sellingpartnerapi::notifications")(req)
 		}),
 	)
 
@@ -140,12 +227,48 @@ func (r *notificationDestinationResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	if destination.Model.Errors != nil {
+		for _, error := range *destination.Model.Errors {
+			resp.Diagnostics.AddError("Error creating destination", error.Message)
+		}
+		return
+	}
+
+	if destination.Model.Payload == nil {
+		resp.Diagnostics.AddError("Error creating destination", "The response did not include the destination.")
+		return
+	}
+
 	plan.ID = types.StringValue(destination.Model.Payload.DestinationId)
 
 	if plan.Resource.EventBridge != nil {
-		plan.Resource.EventBridge.Name = types.StringValue(destination.Model.Payload.Resource.EventBridge.Name)
+		plan.Resource.setEventBridge(destination.Model.Payload.Resource.EventBridge)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Resource.EventBridge == nil || !plan.WaitForAssociation.ValueBool() || plan.Resource.EventBridge.AssociationStatus.ValueString() == eventBridgeAssociationCreated {
+		return
+	}
+
+	// The destination has been created, so errors from here on are warnings:
+	// an error would taint the resource and create another destination. Read
+	// picks up the partner event source once it is reported.
+	eventBridge, err := r.waitForPartnerEventSource(ctx, client, plan.ID.ValueString(), plan.AssociationTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Partner event source not reported",
+			fmt.Sprintf("%s. The destination is kept and its association_status is read on the next refresh.", err),
+		)
+		return
 	}
 
+	plan.Resource.setEventBridge(eventBridge)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -155,7 +278,7 @@ func (r *notificationDestinationResource) Create(ctx context.Context, req resour
 
 // Read refreshes the Terraform state with the latest data.
 func (r *notificationDestinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state notificationDestinationModel
+	var state managedNotificationDestinationModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -163,9 +286,9 @@ func (r *notificationDestinationResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	client, err := notifications.NewClientWithResponses("https://sellingpartnerapi-na.amazon.com",
+	client, err := notifications.NewClientWithResponses(r.region.Endpoint,
 		notifications.WithRequestBefore(func(ctx context.Context, req *http.Request) error {
-			return r.sellingPartner.AuthorizeRequestWithScope(req, "sellingpartnerapi::notifications")
+			return r.grantlessTokens.authorizer("sellingpartnerapi::notifications")(req)
 		}),
 	)
 
@@ -175,19 +298,37 @@ func (r *notificationDestinationResource) Read(ctx context.Context, req resource
 	}
 
 	destination, err := client.GetDestinationWithResponse(ctx, state.ID.ValueString())
+	if destination != nil && destination.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting destination", err.Error())
 		return
 	}
 
+	if destination.Model.Errors != nil {
+		for _, error := range *destination.Model.Errors {
+			resp.Diagnostics.AddError("Error getting destination", error.Message)
+		}
+		return
+	}
+
+	if destination.Model.Payload == nil {
+		resp.Diagnostics.AddError("Error getting destination", "The response did not include the destination.")
+		return
+	}
+
 	state.Name = types.StringValue(destination.Model.Payload.Name)
 
+	// The association status of a destination created without waiting, or
+	// whose wait timed out, becomes CREATED here once the partner event
+	// source is reported.
 	if destination.Model.Payload.Resource.Sqs != nil {
-		state.Resource.SQS.ARN = types.StringValue(destination.Model.Payload.Resource.Sqs.Arn)
+		state.Resource.SQS = &notificationDestinationResourceSQSModel{ARN: types.StringValue(destination.Model.Payload.Resource.Sqs.Arn)}
 	} else if destination.Model.Payload.Resource.EventBridge != nil {
-		state.Resource.EventBridge.Region = types.StringValue(destination.Model.Payload.Resource.EventBridge.Region)
-		state.Resource.EventBridge.AccountID = types.StringValue(destination.Model.Payload.Resource.EventBridge.AccountId)
-		state.Resource.EventBridge.Name = types.StringValue(destination.Model.Payload.Resource.EventBridge.Name)
+		state.Resource.setEventBridge(destination.Model.Payload.Resource.EventBridge)
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -199,11 +340,65 @@ func (r *notificationDestinationResource) Read(ctx context.Context, req resource
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *notificationDestinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state managedNotificationDestinationModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Destinations cannot be updated through the SP-API, only the lifecycle
+	// settings that never leave Terraform can change in place.
+	state.WaitForAssociation = plan.WaitForAssociation
+	state.AssociationTimeout = plan.AssociationTimeout
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// waitForPartnerEventSource polls getDestination until the SP-API reports the
+// partner event source of an EventBridge destination or the timeout expires.
+func (r *notificationDestinationResource) waitForPartnerEventSource(ctx context.Context, client *notifications.ClientWithResponses, destinationID string, timeout string) (*notifications.EventBridgeResource, error) {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid association_timeout %q: %w", timeout, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		destination, err := client.GetDestinationWithResponse(ctx, destinationID)
+		if err != nil {
+			return nil, err
+		}
+
+		if eventBridge := destination.Model.Payload.Resource.EventBridge; eventBridge != nil && eventBridge.Name != "" {
+			return eventBridge, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for partner event source", map[string]interface{}{"destination_id": destinationID})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("partner event source of destination %s was not reported within %s", destinationID, duration)
+		case <-ticker.C:
+		}
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *notificationDestinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state notificationDestinationModel
+	var state managedNotificationDestinationModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -211,9 +406,9 @@ func (r *notificationDestinationResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	client, err := notifications.NewClientWithResponses("https://sellingpartnerapi-na.amazon.com",
+	client, err := notifications.NewClientWithResponses(r.region.Endpoint,
 		notifications.WithRequestBefore(func(ctx context.Context, req *http.Request) error {
-			return r.sellingPartner.AuthorizeRequestWithScope(req, "sellingpartnerapi::notifications")
+			return r.grantlessTokens.authorizer("sellingpartnerapi::notifications")(req)
 		}),
 	)
 
@@ -6,7 +6,6 @@ import (
 	"net/http"
 
 	"github.com/brandedtech/sp-api-sdk/notifications"
-	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,7 +21,8 @@ func NewNotificationDestinationsDatasource() datasource.DataSource {
 }
 
 type notificationDestinationsDatasource struct {
-	sellingPartner *sp.SellingPartner
+	grantlessTokens *grantlessTokens
+	region          spapiRegion
 }
 
 type notificationDestinationsDataSourceModel struct {
@@ -34,17 +34,18 @@ func (n *notificationDestinationsDatasource) Configure(_ context.Context, req da
 		return
 	}
 
-	sellingPartner, ok := req.ProviderData.(*sp.SellingPartner)
+	providerData, ok := req.ProviderData.(*spapiProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sp.SellingPartner, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	n.sellingPartner = sellingPartner
+	n.grantlessTokens = providerData.GrantlessTokens
+	n.region = providerData.Region
 }
 
 func (d *notificationDestinationsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -89,6 +90,15 @@ func (d *notificationDestinationsDatasource) Schema(ctx context.Context, req dat
 										"account_id": schema.StringAttribute{
 											Computed: true,
 										},
+										"partner_event_source_name": schema.StringAttribute{
+											Computed: true,
+										},
+										"partner_event_source_arn": schema.StringAttribute{
+											Computed: true,
+										},
+										"association_status": schema.StringAttribute{
+											Computed: true,
+										},
 									},
 								},
 							},
@@ -103,9 +113,9 @@ func (d *notificationDestinationsDatasource) Schema(ctx context.Context, req dat
 func (n *notificationDestinationsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state notificationDestinationsDataSourceModel
 
-	client, err := notifications.NewClientWithResponses("https://sellingpartnerapi-na.amazon.com",
+	client, err := notifications.NewClientWithResponses(n.region.Endpoint,
 		notifications.WithRequestBefore(func(ctx context.Context, req *http.Request) error {
-			return n.sellingPartner.AuthorizeRequestWithScope(req, "sellingpartnerapi::notifications")
+			return n.grantlessTokens.authorizer("sellingpartnerapi::notifications")(req)
 		}),
 	)
 
@@ -134,11 +144,7 @@ func (n *notificationDestinationsDatasource) Read(ctx context.Context, req datas
 		}
 
 		if destination.Resource.EventBridge != nil {
-			model.Resource.EventBridge = &notificationDestinationResourceEventBridgeModel{
-				Name:      types.StringValue(destination.Resource.EventBridge.Name),
-				Region:    types.StringValue(destination.Resource.EventBridge.Region),
-				AccountID: types.StringValue(destination.Resource.EventBridge.AccountId),
-			}
+			model.Resource.EventBridge = newNotificationDestinationResourceEventBridgeModel(*destination.Resource.EventBridge)
 		}
 
 		state.Destinations = append(state.Destinations, model)
@@ -1,6 +1,22 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applicationNotificationTypes are the notification types about the
+// application itself rather than a selling partner. Subscriptions to them are
+// grantless, so they are managed without seller context.
+var applicationNotificationTypes = []string{
+	"APPLICATION_OAUTH_CLIENT_NEW_SECRET",
+	"APPLICATION_OAUTH_CLIENT_SECRET_EXPIRY",
+}
+
+func isApplicationNotificationType(notificationType string) bool {
+	return slices.Contains(applicationNotificationTypes, notificationType)
+}
 
 type notificationSubscriptionModel struct {
 	ID               types.String `tfsdk:"id"`
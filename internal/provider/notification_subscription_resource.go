@@ -9,7 +9,9 @@ import (
 	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -26,7 +28,9 @@ func NewNotificationSubscriptionResource() resource.Resource {
 
 // orderResource is the resource implementation.
 type notificationSubscriptionResource struct {
-	sellingPartner *sp.SellingPartner
+	sellingPartner  *sp.SellingPartner
+	grantlessTokens *grantlessTokens
+	region          spapiRegion
 }
 
 // Metadata returns the resource type name.
@@ -39,18 +43,20 @@ func (r *notificationSubscriptionResource) Configure(_ context.Context, req reso
 		return
 	}
 
-	sellingPartner, ok := req.ProviderData.(*sp.SellingPartner)
+	providerData, ok := req.ProviderData.(*spapiProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sp.SellingPartner, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.sellingPartner = sellingPartner
+	r.sellingPartner = providerData.SellingPartner
+	r.grantlessTokens = providerData.GrantlessTokens
+	r.region = providerData.Region
 }
 
 // Schema defines the schema for the resource.
@@ -59,9 +65,18 @@ func (r *notificationSubscriptionResource) Schema(_ context.Context, _ resource.
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"notification_type": schema.StringAttribute{
 				Required: true,
+				Description: "Type of notification to subscribe to. The application-level types " +
+					"APPLICATION_OAUTH_CLIENT_NEW_SECRET and APPLICATION_OAUTH_CLIENT_SECRET_EXPIRY are subscribed to " +
+					"without seller context.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"payload_version": schema.StringAttribute{
 				Computed: true,
@@ -69,6 +84,9 @@ func (r *notificationSubscriptionResource) Schema(_ context.Context, _ resource.
 			},
 			"destination_id": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
@@ -84,8 +102,14 @@ func (r *notificationSubscriptionResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	client, err := notifications.NewClientWithResponses("https://sellingpartnerapi-na.amazon.com",
+	notificationType := plan.NotificationType.ValueString()
+
+	client, err := notifications.NewClientWithResponses(r.region.Endpoint,
 		notifications.WithRequestBefore(func(ctx context.Context, req *http.Request) error {
+			if isApplicationNotificationType(notificationType) {
+				return r.grantlessTokens.authorizer("sellingpartnerapi::notifications")(req)
+			}
+
 			return r.sellingPartner.AuthorizeRequest(req)
 		}),
 	)
@@ -103,13 +127,25 @@ func (r *notificationSubscriptionResource) Create(ctx context.Context, req resou
 		DestinationId:  &destinationId,
 	}
 
-	subscription, err := client.CreateSubscriptionWithResponse(ctx, notifications.NotificationType(plan.NotificationType.ValueString()), body)
+	subscription, err := client.CreateSubscriptionWithResponse(ctx, notifications.NotificationType(notificationType), body)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating subscription", err.Error())
 		return
 	}
 
+	if subscription.Model.Errors != nil {
+		for _, error := range *subscription.Model.Errors {
+			resp.Diagnostics.AddError("Error creating subscription", error.Message)
+		}
+		return
+	}
+
+	if subscription.Model.Payload == nil {
+		resp.Diagnostics.AddError("Error creating subscription", "The response did not include the subscription.")
+		return
+	}
+
 	plan.ID = types.StringValue(subscription.Model.Payload.SubscriptionId)
 
 	diags = resp.State.Set(ctx, plan)
@@ -129,9 +165,9 @@ func (r *notificationSubscriptionResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	client, err := notifications.NewClientWithResponses("https://sellingpartnerapi-na.amazon.com",
+	client, err := notifications.NewClientWithResponses(r.region.Endpoint,
 		notifications.WithRequestBefore(func(ctx context.Context, req *http.Request) error {
-			return r.sellingPartner.AuthorizeRequestWithScope(req, "sellingpartnerapi::notifications")
+			return r.grantlessTokens.authorizer("sellingpartnerapi::notifications")(req)
 		}),
 	)
 
@@ -141,11 +177,28 @@ func (r *notificationSubscriptionResource) Read(ctx context.Context, req resourc
 	}
 
 	subscription, err := client.GetSubscriptionByIdWithResponse(ctx, notifications.NotificationType(state.NotificationType.ValueString()), state.ID.ValueString())
+	if subscription != nil && subscription.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting subscription", err.Error())
 		return
 	}
 
+	if subscription.Model.Errors != nil {
+		for _, error := range *subscription.Model.Errors {
+			resp.Diagnostics.AddError("Error getting subscription", error.Message)
+		}
+		return
+	}
+
+	if subscription.Model.Payload == nil {
+		resp.Diagnostics.AddError("Error getting subscription", "The response did not include the subscription.")
+		return
+	}
+
 	state.PayloadVersion = types.StringValue(subscription.Model.Payload.PayloadVersion)
 	state.DestinationID = types.StringValue(subscription.Model.Payload.DestinationId)
 
@@ -170,9 +223,9 @@ func (r *notificationSubscriptionResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	client, err := notifications.NewClientWithResponses("https://sellingpartnerapi-na.amazon.com",
+	client, err := notifications.NewClientWithResponses(r.region.Endpoint,
 		notifications.WithRequestBefore(func(ctx context.Context, req *http.Request) error {
-			return r.sellingPartner.AuthorizeRequestWithScope(req, "sellingpartnerapi::notifications")
+			return r.grantlessTokens.authorizer("sellingpartnerapi::notifications")(req)
 		}),
 	)
 	if err != nil {
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOneOfValidator(t *testing.T) {
+	tests := map[string]struct {
+		value types.String
+		error bool
+	}{
+		"null":       {value: types.StringNull()},
+		"unknown":    {value: types.StringUnknown()},
+		"allowed":    {value: types.StringValue("CSV")},
+		"lower case": {value: types.StringValue("csv"), error: true},
+		"other":      {value: types.StringValue("JSON"), error: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			oneOfValidator{values: reportContentFormats}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("format"),
+				ConfigValue: test.value,
+			}, resp)
+
+			if resp.Diagnostics.HasError() != test.error {
+				t.Errorf("got diagnostics %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &productTypeDefinitionDatasource{}
+	_ datasource.DataSourceWithConfigure = &productTypeDefinitionDatasource{}
+)
+
+// productTypeRequirements are the requirements values of getDefinitionsProductType.
+var productTypeRequirements = []string{"LISTING", "LISTING_PRODUCT_ONLY", "LISTING_OFFER_ONLY"}
+
+func NewProductTypeDefinitionDatasource() datasource.DataSource {
+	return &productTypeDefinitionDatasource{}
+}
+
+type productTypeDefinitionDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type productTypeDefinitionDataSourceModel struct {
+	ProductType          types.String                             `tfsdk:"product_type"`
+	MarketplaceID        types.String                             `tfsdk:"marketplace_id"`
+	SellerID             types.String                             `tfsdk:"seller_id"`
+	ProductTypeVersion   types.String                             `tfsdk:"product_type_version"`
+	Requirements         types.String                             `tfsdk:"requirements"`
+	RequirementsEnforced types.String                             `tfsdk:"requirements_enforced"`
+	Locale               types.String                             `tfsdk:"locale"`
+	CacheDirectory       types.String                             `tfsdk:"cache_directory"`
+	DisplayName          types.String                             `tfsdk:"display_name"`
+	Version              types.String                             `tfsdk:"version"`
+	ReleaseCandidate     types.Bool                               `tfsdk:"release_candidate"`
+	SchemaJSON           types.String                             `tfsdk:"schema_json"`
+	MetaSchemaJSON       types.String                             `tfsdk:"meta_schema_json"`
+	RequiredAttributes   []types.String                           `tfsdk:"required_attributes"`
+	PropertyGroups       map[string]productTypePropertyGroupModel `tfsdk:"property_groups"`
+}
+
+type productTypePropertyGroupModel struct {
+	Title         types.String   `tfsdk:"title"`
+	Description   types.String   `tfsdk:"description"`
+	PropertyNames []types.String `tfsdk:"property_names"`
+}
+
+func (d *productTypeDefinitionDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *productTypeDefinitionDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_type_definition"
+}
+
+func (d *productTypeDefinitionDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the JSON schema of a product type with getDefinitionsProductType. Downloaded schemas are cached on disk by product type version.",
+		Attributes: map[string]schema.Attribute{
+			"product_type": schema.StringAttribute{
+				Required: true,
+			},
+			"marketplace_id": schema.StringAttribute{
+				Required: true,
+			},
+			"seller_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "When set, the schema is tailored to the seller, for example for brand-restricted attributes.",
+			},
+			"product_type_version": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Version to retrieve, LATEST (default), RELEASE_CANDIDATE or a specific version.",
+			},
+			"requirements": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "LISTING (default), LISTING_PRODUCT_ONLY or LISTING_OFFER_ONLY.",
+			},
+			"requirements_enforced": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ENFORCED (default) or NOT_ENFORCED.",
+			},
+			"locale": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Locale of the display labels, DEFAULT (default) uses the primary locale of the marketplace.",
+			},
+			"cache_directory": schema.StringAttribute{
+				Optional:    true,
+				Description: "Directory caching downloaded schemas. Defaults to terraform-provider-spapi/product-types in the user cache directory.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"version": schema.StringAttribute{
+				Computed: true,
+			},
+			"release_candidate": schema.BoolAttribute{
+				Computed: true,
+			},
+			"schema_json": schema.StringAttribute{
+				Computed: true,
+			},
+			"meta_schema_json": schema.StringAttribute{
+				Computed: true,
+			},
+			"required_attributes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"property_groups": schema.MapNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"property_names": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *productTypeDefinitionDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state productTypeDefinitionDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ProductTypeVersion.IsNull() {
+		state.ProductTypeVersion = types.StringValue("LATEST")
+	}
+
+	if state.Requirements.IsNull() {
+		state.Requirements = types.StringValue("LISTING")
+	}
+
+	if state.RequirementsEnforced.IsNull() {
+		state.RequirementsEnforced = types.StringValue("ENFORCED")
+	}
+
+	if state.Locale.IsNull() {
+		state.Locale = types.StringValue("DEFAULT")
+	}
+
+	if !slices.Contains(productTypeRequirements, state.Requirements.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requirements"),
+			"Invalid requirements",
+			fmt.Sprintf("%q is not one of %s.", state.Requirements.ValueString(), strings.Join(productTypeRequirements, ", ")),
+		)
+	}
+
+	if !slices.Contains([]string{"ENFORCED", "NOT_ENFORCED"}, state.RequirementsEnforced.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requirements_enforced"),
+			"Invalid requirements enforcement",
+			fmt.Sprintf("%q is not one of ENFORCED, NOT_ENFORCED.", state.RequirementsEnforced.ValueString()),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	query.Set("productTypeVersion", state.ProductTypeVersion.ValueString())
+	query.Set("requirements", state.Requirements.ValueString())
+	query.Set("requirementsEnforced", state.RequirementsEnforced.ValueString())
+	query.Set("locale", state.Locale.ValueString())
+	if !state.SellerID.IsNull() {
+		query.Set("sellerId", state.SellerID.ValueString())
+	}
+
+	client := newProductTypeDefinitionsClient(d.sellingPartner, d.region)
+
+	definition, err := client.GetDefinitionsProductType(ctx, state.ProductType.ValueString(), []string{state.MarketplaceID.ValueString()}, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting product type definition", err.Error())
+		return
+	}
+
+	cache, err := newProductTypeSchemaCache(state.CacheDirectory.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error locating product type schema cache", err.Error())
+		return
+	}
+
+	schemaJSON, err := cache.document(ctx, definition, "schema", definition.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Error downloading product type schema", err.Error())
+		return
+	}
+
+	state.MetaSchemaJSON = types.StringNull()
+	if definition.MetaSchema != nil {
+		metaSchemaJSON, err := cache.document(ctx, definition, "meta-schema", *definition.MetaSchema)
+		if err != nil {
+			resp.Diagnostics.AddError("Error downloading product type meta-schema", err.Error())
+			return
+		}
+
+		state.MetaSchemaJSON = types.StringValue(string(metaSchemaJSON))
+	}
+
+	required, err := productTypeSchemaRequired(schemaJSON)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing product type schema", err.Error())
+		return
+	}
+
+	state.DisplayName = types.StringValue(definition.DisplayName)
+	state.Version = types.StringValue(definition.ProductTypeVersion.Version)
+	state.ReleaseCandidate = types.BoolValue(definition.ProductTypeVersion.ReleaseCandidate)
+	state.SchemaJSON = types.StringValue(string(schemaJSON))
+
+	state.RequiredAttributes = make([]types.String, 0, len(required))
+	for _, name := range required {
+		state.RequiredAttributes = append(state.RequiredAttributes, types.StringValue(name))
+	}
+
+	state.PropertyGroups = make(map[string]productTypePropertyGroupModel, len(definition.PropertyGroups))
+	for name, group := range definition.PropertyGroups {
+		propertyNames := make([]types.String, 0, len(group.PropertyNames))
+		for _, propertyName := range group.PropertyNames {
+			propertyNames = append(propertyNames, types.StringValue(propertyName))
+		}
+
+		state.PropertyGroups[name] = productTypePropertyGroupModel{
+			Title:         types.StringValue(group.Title),
+			Description:   types.StringValue(group.Description),
+			PropertyNames: propertyNames,
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+)
+
+// productTypeDefinitionsClient calls the Product Type Definitions API
+// (version 2020-09-01), which the SDK does not generate a client for.
+type productTypeDefinitionsClient struct {
+	sellingPartner *sp.SellingPartner
+	endpoint       string
+}
+
+func newProductTypeDefinitionsClient(sellingPartner *sp.SellingPartner, region spapiRegion) *productTypeDefinitionsClient {
+	return &productTypeDefinitionsClient{
+		sellingPartner: sellingPartner,
+		endpoint:       region.Endpoint,
+	}
+}
+
+type productTypeSchemaLink struct {
+	Link struct {
+		Resource string `json:"resource"`
+		Verb     string `json:"verb"`
+	} `json:"link"`
+	Checksum string `json:"checksum"`
+}
+
+// verify reports whether content matches the checksum of the link, which is
+// the base64 encoded MD5 hash of the document. Links without a checksum
+// match any content.
+func (l productTypeSchemaLink) verify(content []byte) bool {
+	if l.Checksum == "" {
+		return true
+	}
+
+	sum := md5.Sum(content)
+
+	return base64.StdEncoding.EncodeToString(sum[:]) == l.Checksum
+}
+
+type productTypePropertyGroup struct {
+	Title         string   `json:"title"`
+	Description   string   `json:"description"`
+	PropertyNames []string `json:"propertyNames"`
+}
+
+type productTypeVersion struct {
+	Version          string `json:"version"`
+	Latest           bool   `json:"latest"`
+	ReleaseCandidate bool   `json:"releaseCandidate"`
+}
+
+type productTypeDefinition struct {
+	MetaSchema           *productTypeSchemaLink              `json:"metaSchema"`
+	Schema               productTypeSchemaLink               `json:"schema"`
+	Requirements         string                              `json:"requirements"`
+	RequirementsEnforced string                              `json:"requirementsEnforced"`
+	PropertyGroups       map[string]productTypePropertyGroup `json:"propertyGroups"`
+	Locale               string                              `json:"locale"`
+	MarketplaceIDs       []string                            `json:"marketplaceIds"`
+	ProductType          string                              `json:"productType"`
+	DisplayName          string                              `json:"displayName"`
+	ProductTypeVersion   productTypeVersion                  `json:"productTypeVersion"`
+}
+
+// GetDefinitionsProductType returns the definition of a product type. query
+// holds the optional parameters besides marketplaceIds.
+func (c *productTypeDefinitionsClient) GetDefinitionsProductType(ctx context.Context, productType string, marketplaceIDs []string, query url.Values) (*productTypeDefinition, error) {
+	definitionQuery := url.Values{}
+	for key, values := range query {
+		definitionQuery[key] = values
+	}
+
+	definitionQuery.Set("marketplaceIds", strings.Join(marketplaceIDs, ","))
+
+	var definition productTypeDefinition
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/definitions/2020-09-01/productTypes/"+url.PathEscape(productType), definitionQuery, nil, &definition); err != nil {
+		return nil, err
+	}
+
+	return &definition, nil
+}
+
+type productTypeSummary struct {
+	Name           string   `json:"name"`
+	DisplayName    string   `json:"displayName"`
+	MarketplaceIDs []string `json:"marketplaceIds"`
+}
+
+type productTypeList struct {
+	ProductTypes       []productTypeSummary `json:"productTypes"`
+	ProductTypeVersion string               `json:"productTypeVersion"`
+}
+
+// SearchDefinitionsProductTypes returns the product types matching query,
+// which holds keywords or itemName and the optional locale parameters.
+func (c *productTypeDefinitionsClient) SearchDefinitionsProductTypes(ctx context.Context, marketplaceIDs []string, query url.Values) (*productTypeList, error) {
+	searchQuery := url.Values{}
+	for key, values := range query {
+		searchQuery[key] = values
+	}
+
+	searchQuery.Set("marketplaceIds", strings.Join(marketplaceIDs, ","))
+
+	var list productTypeList
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/definitions/2020-09-01/productTypes", searchQuery, nil, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// productTypeSchemaCache keeps downloaded product type schemas on disk. A
+// product type version never changes once released, so the version is part
+// of the key and entries are never invalidated.
+type productTypeSchemaCache struct {
+	directory string
+}
+
+// newProductTypeSchemaCache returns a cache rooted at directory, or at the
+// user cache directory when directory is empty.
+func newProductTypeSchemaCache(directory string) (*productTypeSchemaCache, error) {
+	if directory == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+
+		directory = filepath.Join(userCacheDir, "terraform-provider-spapi", "product-types")
+	}
+
+	return &productTypeSchemaCache{directory: directory}, nil
+}
+
+func (c *productTypeSchemaCache) path(definition *productTypeDefinition, document string) string {
+	name := strings.Join([]string{
+		strings.Join(definition.MarketplaceIDs, "_"),
+		definition.Requirements,
+		definition.RequirementsEnforced,
+		definition.Locale,
+		document,
+	}, "-") + ".json"
+
+	return filepath.Join(c.directory, definition.ProductType, definition.ProductTypeVersion.Version, name)
+}
+
+// document returns the named document of a product type definition, reading
+// it from the cache when present and downloading it from link otherwise.
+// Release candidates are always downloaded since they can still change.
+func (c *productTypeSchemaCache) document(ctx context.Context, definition *productTypeDefinition, document string, link productTypeSchemaLink) ([]byte, error) {
+	cachePath := c.path(definition, document)
+	cacheable := definition.ProductTypeVersion.Version != "" && !definition.ProductTypeVersion.ReleaseCandidate
+
+	if cacheable {
+		if content, err := os.ReadFile(cachePath); err == nil && link.verify(content) {
+			return content, nil
+		}
+	}
+
+	content, err := downloadDocument(ctx, link.Link.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if !json.Valid(content) {
+		return nil, fmt.Errorf("the %s document of product type %s is not valid JSON", document, definition.ProductType)
+	}
+
+	// A document that does not match its checksum may have been changed or
+	// cut short in transit, and is not used.
+	if !link.verify(content) {
+		return nil, fmt.Errorf("the %s document of product type %s does not match its checksum %s", document, definition.ProductType, link.Checksum)
+	}
+
+	if cacheable {
+		if err := os.MkdirAll(filepath.Dir(cachePath), 0o755); err != nil {
+			return nil, err
+		}
+
+		// Write to a temporary file first so concurrent readers never see a partial document.
+		tmp, err := os.CreateTemp(filepath.Dir(cachePath), filepath.Base(cachePath)+".*")
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tmp.Write(content); err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return nil, err
+		}
+
+		if err := tmp.Close(); err != nil {
+			os.Remove(tmp.Name())
+			return nil, err
+		}
+
+		if err := os.Rename(tmp.Name(), cachePath); err != nil {
+			os.Remove(tmp.Name())
+			return nil, err
+		}
+	}
+
+	return content, nil
+}
+
+// productTypeSchemaRequired returns the top-level required attribute names of
+// a product type schema.
+func productTypeSchemaRequired(schemaJSON []byte) ([]string, error) {
+	var schema struct {
+		Required []string `json:"required"`
+	}
+
+	if err := json.Unmarshal(schemaJSON, &schema); err != nil {
+		return nil, err
+	}
+
+	return schema.Required, nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProductTypeSchemaCache(t *testing.T) {
+	downloads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads++
+		_, _ = w.Write([]byte(`{"required": ["item_name", "brand"]}`))
+	}))
+	defer server.Close()
+
+	cache, err := newProductTypeSchemaCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	definition := &productTypeDefinition{
+		ProductType:        "LUGGAGE",
+		MarketplaceIDs:     []string{"ATVPDKIKX0DER"},
+		Requirements:       "LISTING",
+		Locale:             "en_US",
+		ProductTypeVersion: productTypeVersion{Version: "U1234", Latest: true},
+	}
+
+	var link productTypeSchemaLink
+	link.Link.Resource = server.URL
+
+	for i := 0; i < 2; i++ {
+		content, err := cache.document(context.Background(), definition, "schema", link)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		required, err := productTypeSchemaRequired(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(required) != 2 || required[0] != "item_name" || required[1] != "brand" {
+			t.Errorf("required = %v, want [item_name brand]", required)
+		}
+	}
+
+	if downloads != 1 {
+		t.Errorf("downloaded the schema %d times, want 1", downloads)
+	}
+
+	definition.ProductTypeVersion.ReleaseCandidate = true
+	if _, err := cache.document(context.Background(), definition, "schema", link); err != nil {
+		t.Fatal(err)
+	}
+
+	if downloads != 2 {
+		t.Errorf("release candidates should bypass the cache, downloaded %d times", downloads)
+	}
+}
+
+func TestProductTypeSchemaCacheChecksum(t *testing.T) {
+	document := []byte(`{"required": ["item_name"]}`)
+	sum := md5.Sum(document)
+
+	downloads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads++
+		_, _ = w.Write(document)
+	}))
+	defer server.Close()
+
+	cache, err := newProductTypeSchemaCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	definition := &productTypeDefinition{
+		ProductType:        "LUGGAGE",
+		MarketplaceIDs:     []string{"ATVPDKIKX0DER"},
+		ProductTypeVersion: productTypeVersion{Version: "U1234", Latest: true},
+	}
+
+	var link productTypeSchemaLink
+	link.Link.Resource = server.URL
+	link.Checksum = "bWlzbWF0Y2hlZCBjaGVja3N1bQ=="
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.document(context.Background(), definition, "schema", link); err == nil {
+			t.Fatal("a document with a wrong checksum was used")
+		}
+	}
+
+	if downloads != 2 {
+		t.Errorf("documents with a wrong checksum should not be cached, downloaded %d times", downloads)
+	}
+
+	link.Checksum = base64.StdEncoding.EncodeToString(sum[:])
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.document(context.Background(), definition, "schema", link); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if downloads != 3 {
+		t.Errorf("documents with a matching checksum should be cached, downloaded %d times", downloads)
+	}
+}
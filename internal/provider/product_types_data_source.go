@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &productTypesDatasource{}
+	_ datasource.DataSourceWithConfigure        = &productTypesDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &productTypesDatasource{}
+)
+
+func NewProductTypesDatasource() datasource.DataSource {
+	return &productTypesDatasource{}
+}
+
+type productTypesDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type productTypesDataSourceModel struct {
+	MarketplaceID      types.String            `tfsdk:"marketplace_id"`
+	Keywords           []types.String          `tfsdk:"keywords"`
+	ItemName           types.String            `tfsdk:"item_name"`
+	Locale             types.String            `tfsdk:"locale"`
+	SearchLocale       types.String            `tfsdk:"search_locale"`
+	ProductTypeVersion types.String            `tfsdk:"product_type_version"`
+	ProductTypes       []productTypeEntryModel `tfsdk:"product_types"`
+}
+
+type productTypeEntryModel struct {
+	Name           types.String   `tfsdk:"name"`
+	DisplayName    types.String   `tfsdk:"display_name"`
+	MarketplaceIDs []types.String `tfsdk:"marketplace_ids"`
+}
+
+func (d *productTypesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *productTypesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_types"
+}
+
+func (d *productTypesDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches product types with searchDefinitionsProductTypes, by keywords or by an item name.",
+		Attributes: map[string]schema.Attribute{
+			"marketplace_id": schema.StringAttribute{
+				Required: true,
+			},
+			"keywords": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Keywords to search product types by. Conflicts with item_name.",
+			},
+			"item_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Title of an item to get product type recommendations for. Conflicts with keywords.",
+			},
+			"locale": schema.StringAttribute{
+				Optional:    true,
+				Description: "Locale of the display names, defaults to the primary locale of the marketplace.",
+			},
+			"search_locale": schema.StringAttribute{
+				Optional:    true,
+				Description: "Locale of item_name, defaults to the primary locale of the marketplace.",
+			},
+			"product_type_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"product_types": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"marketplace_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *productTypesDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{productTypesSearchValidator{}}
+}
+
+// productTypesSearchValidator requires exactly one of keywords and item_name,
+// which searchDefinitionsProductTypes rejects together.
+type productTypesSearchValidator struct{}
+
+func (v productTypesSearchValidator) Description(_ context.Context) string {
+	return "Exactly one of keywords and item_name must be set."
+}
+
+func (v productTypesSearchValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v productTypesSearchValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var keywords types.List
+	var itemName types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("keywords"), &keywords)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item_name"), &itemName)...)
+	if resp.Diagnostics.HasError() || keywords.IsUnknown() || itemName.IsUnknown() {
+		return
+	}
+
+	if keywords.IsNull() == itemName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keywords"),
+			"Invalid product type search",
+			v.Description(ctx),
+		)
+	}
+}
+
+func (d *productTypesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state productTypesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+
+	if len(state.Keywords) > 0 {
+		keywords := make([]string, 0, len(state.Keywords))
+		for _, keyword := range state.Keywords {
+			keywords = append(keywords, keyword.ValueString())
+		}
+
+		query.Set("keywords", strings.Join(keywords, ","))
+	}
+
+	if !state.ItemName.IsNull() {
+		query.Set("itemName", state.ItemName.ValueString())
+	}
+
+	if !state.Locale.IsNull() {
+		query.Set("locale", state.Locale.ValueString())
+	}
+
+	if !state.SearchLocale.IsNull() {
+		query.Set("searchLocale", state.SearchLocale.ValueString())
+	}
+
+	client := newProductTypeDefinitionsClient(d.sellingPartner, d.region)
+
+	list, err := client.SearchDefinitionsProductTypes(ctx, []string{state.MarketplaceID.ValueString()}, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching product types", err.Error())
+		return
+	}
+
+	state.ProductTypeVersion = types.StringValue(list.ProductTypeVersion)
+
+	state.ProductTypes = make([]productTypeEntryModel, 0, len(list.ProductTypes))
+	for _, productType := range list.ProductTypes {
+		marketplaceIDs := make([]types.String, 0, len(productType.MarketplaceIDs))
+		for _, id := range productType.MarketplaceIDs {
+			marketplaceIDs = append(marketplaceIDs, types.StringValue(id))
+		}
+
+		state.ProductTypes = append(state.ProductTypes, productTypeEntryModel{
+			Name:           types.StringValue(productType.Name),
+			DisplayName:    types.StringValue(productType.DisplayName),
+			MarketplaceIDs: marketplaceIDs,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -16,7 +19,8 @@ import (
 )
 
 var (
-	_ provider.Provider = &SPAPIProvider{}
+	_ provider.Provider              = &SPAPIProvider{}
+	_ provider.ProviderWithFunctions = &SPAPIProvider{}
 )
 
 type SPAPIProvider struct {
@@ -30,6 +34,24 @@ type SPAPIProviderModel struct {
 	LWAClientID     types.String `tfsdk:"lwa_client_id"`
 	LWAClientSecret types.String `tfsdk:"lwa_client_secret"`
 	RefreshToken    types.String `tfsdk:"refresh_token"`
+	Region          types.String `tfsdk:"region"`
+
+	LWAClientSecretExpiryTime             types.String `tfsdk:"lwa_client_secret_expiry_time"`
+	LWAClientSecretExpiryWarningThreshold types.String `tfsdk:"lwa_client_secret_expiry_warning_threshold"`
+}
+
+// defaultLWAClientSecretExpiryWarningThreshold is how long before the LWA
+// client secret expires the provider starts warning about it.
+const defaultLWAClientSecretExpiryWarningThreshold = 30 * 24 * time.Hour
+
+// spapiProviderData is handed to resources and data sources in Configure.
+type spapiProviderData struct {
+	SellingPartner *sp.SellingPartner
+	// GrantlessTokens authorizes grantless operations, which must not use
+	// SellingPartner.
+	GrantlessTokens *grantlessTokens
+	Region          spapiRegion
+	LWAClientID     string
 }
 
 func New(version string) func() provider.Provider {
@@ -59,6 +81,23 @@ func (p *SPAPIProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Required:  true,
 				Sensitive: true,
 			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "SP-API region to send requests to: na, eu or fe. Defaults to the SP_API_REGION environment variable or na.",
+			},
+			"lwa_client_secret_expiry_time": schema.StringAttribute{
+				Optional: true,
+				Description: "When the LWA client secret expires, in RFC 3339 format, as reported by the " +
+					"APPLICATION_OAUTH_CLIENT_NEW_SECRET and APPLICATION_OAUTH_CLIENT_SECRET_EXPIRY notifications, e.g. the " +
+					"client_secret_expiry_time of spapi_application_client_secret. The SP-API has no operation that " +
+					"returns it, so it has to be configured. Defaults to the SP_API_LWA_CLIENT_SECRET_EXPIRY_TIME " +
+					"environment variable.",
+			},
+			"lwa_client_secret_expiry_warning_threshold": schema.StringAttribute{
+				Optional: true,
+				Description: "How long before lwa_client_secret_expiry_time the provider warns that the secret needs to " +
+					"be rotated, as a Go duration. Defaults to 720h.",
+			},
 		},
 	}
 }
@@ -100,6 +139,15 @@ func (p *SPAPIProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.Region.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown SP-API region",
+			"The provider cannot create the SP-API client as there is an unknown configuration value for the SP-API region. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SP_API_REGION environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -107,6 +155,7 @@ func (p *SPAPIProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	lwaClientID := os.Getenv("SP_API_LWA_CLIENT_ID")
 	lwaClientSecret := os.Getenv("SP_API_LWA_CLIENT_SECRET")
 	refreshToken := os.Getenv("SP_API_REFRESH_TOKEN")
+	regionName := os.Getenv("SP_API_REGION")
 
 	if !config.LWAClientID.IsNull() {
 		lwaClientID = config.LWAClientID.ValueString()
@@ -120,6 +169,14 @@ func (p *SPAPIProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		refreshToken = config.RefreshToken.ValueString()
 	}
 
+	if !config.Region.IsNull() {
+		regionName = config.Region.ValueString()
+	}
+
+	if regionName == "" {
+		regionName = "na"
+	}
+
 	if lwaClientID == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("lwa_client_id"),
@@ -147,15 +204,26 @@ func (p *SPAPIProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	region, ok := spapiRegions[regionName]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Invalid SP-API region",
+			fmt.Sprintf("The SP-API region %q is not supported. Use one of na, eu or fe.", regionName),
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	sellingPartner, err := sp.NewSellingPartner(&sp.Config{
+	spConfig := &sp.Config{
 		ClientID:     lwaClientID,
 		ClientSecret: lwaClientSecret,
 		RefreshToken: refreshToken,
-	})
+	}
+
+	sellingPartner, err := sp.NewSellingPartner(spConfig)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -166,23 +234,131 @@ func (p *SPAPIProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	resp.DataSourceData = sellingPartner
-	resp.ResourceData = sellingPartner
+	resp.Diagnostics.Append(lwaClientSecretExpiryDiagnostics(config, time.Now())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerData := &spapiProviderData{
+		SellingPartner:  sellingPartner,
+		GrantlessTokens: newGrantlessTokens(spConfig),
+		Region:          region,
+		LWAClientID:     lwaClientID,
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *SPAPIProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewNotificationDestinationResource,
 		NewNotificationSubscriptionResource,
+		NewListingsItemResource,
+		NewListingsItemOfferResource,
+		NewFeedResource,
+		NewListingsFeedResource,
+		NewReportScheduleResource,
+		NewReportDocumentResource,
+		NewDataKioskQueryResource,
+		NewApplicationClientSecretResource,
 	}
 }
 
 func (p *SPAPIProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewNotificationDestinationsDatasource,
+		NewMarketplacesDatasource,
+		NewMarketplaceParticipationsDatasource,
+		NewSellerAccountDatasource,
+		NewSQSDestinationPolicyDocumentDatasource,
+		NewListingValidationDatasource,
+		NewListingsItemDatasource,
+		NewListingsItemsDatasource,
+		NewListingsRestrictionsDatasource,
+		NewProductTypeDefinitionDatasource,
+		NewProductTypesDatasource,
+		NewReportDatasource,
+		NewReportsDatasource,
 	}
 }
 
 func (p *SPAPIProvider) Functions(ctx context.Context) []func() function.Function {
-	return nil
+	return []func() function.Function{
+		NewMarketplaceIDFunction,
+		NewValidateListingAttributesFunction,
+	}
+}
+
+// lwaClientSecretExpiryDiagnostics warns when the LWA client secret expires
+// within the configured threshold. An expiry time that is not known yet, e.g.
+// because it comes from a resource that has not been created, is ignored.
+//
+// The expiry has to be configured: the SP-API has no operation that returns
+// it, and rotateApplicationClientSecret does not either. It is only reported
+// by the APPLICATION_OAUTH_CLIENT_NEW_SECRET and
+// APPLICATION_OAUTH_CLIENT_SECRET_EXPIRY notifications, which the provider
+// cannot wait for while it is configured, and reading them from a shared
+// queue here would take them from their consumers. The
+// spapi_application_client_secret resource records the expiry of the
+// notification it receives as client_secret_expiry_time, which is meant to be
+// passed in.
+func lwaClientSecretExpiryDiagnostics(config SPAPIProviderModel, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.LWAClientSecretExpiryTime.IsUnknown() {
+		return diags
+	}
+
+	expiryTime := os.Getenv("SP_API_LWA_CLIENT_SECRET_EXPIRY_TIME")
+	if !config.LWAClientSecretExpiryTime.IsNull() {
+		expiryTime = config.LWAClientSecretExpiryTime.ValueString()
+	}
+
+	if expiryTime == "" {
+		return diags
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiryTime)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("lwa_client_secret_expiry_time"),
+			"Invalid LWA client secret expiry time",
+			fmt.Sprintf("The LWA client secret expiry time %q is not in RFC 3339 format.", expiryTime),
+		)
+		return diags
+	}
+
+	threshold := defaultLWAClientSecretExpiryWarningThreshold
+
+	if !config.LWAClientSecretExpiryWarningThreshold.IsNull() && !config.LWAClientSecretExpiryWarningThreshold.IsUnknown() {
+		if threshold, err = time.ParseDuration(config.LWAClientSecretExpiryWarningThreshold.ValueString()); err != nil {
+			diags.AddAttributeError(
+				path.Root("lwa_client_secret_expiry_warning_threshold"),
+				"Invalid LWA client secret expiry warning threshold",
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	remaining := expiry.Sub(now)
+
+	switch {
+	case remaining <= 0:
+		diags.AddWarning(
+			"LWA client secret has expired",
+			fmt.Sprintf("The LWA client secret expired at %s. Requests to the SP-API will fail until the provider is "+
+				"configured with the new secret.", expiry.Format(time.RFC3339)),
+		)
+	case remaining <= threshold:
+		diags.AddWarning(
+			"LWA client secret expires soon",
+			fmt.Sprintf("The LWA client secret expires at %s, in %s. Rotate it, e.g. with the "+
+				"spapi_application_client_secret resource, and configure the provider with the new secret.",
+				expiry.Format(time.RFC3339), remaining.Truncate(time.Minute)),
+		)
+	}
+
+	return diags
 }
@@ -4,10 +4,16 @@
 package provider
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -23,3 +29,76 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestLWAClientSecretExpiryDiagnostics(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		expiryTime types.String
+		threshold  types.String
+		warning    string
+		error      bool
+	}{
+		"not set":           {expiryTime: types.StringNull(), threshold: types.StringNull()},
+		"unknown":           {expiryTime: types.StringUnknown(), threshold: types.StringNull()},
+		"far away":          {expiryTime: types.StringValue("2024-10-01T00:00:00Z"), threshold: types.StringNull()},
+		"within 30d":        {expiryTime: types.StringValue("2024-05-20T00:00:00Z"), threshold: types.StringNull(), warning: "LWA client secret expires soon"},
+		"custom":            {expiryTime: types.StringValue("2024-05-20T00:00:00Z"), threshold: types.StringValue("72h")},
+		"expired":           {expiryTime: types.StringValue("2024-04-30T00:00:00Z"), threshold: types.StringValue("72h"), warning: "LWA client secret has expired"},
+		"invalid time":      {expiryTime: types.StringValue("2024-05-20"), threshold: types.StringNull(), error: true},
+		"invalid threshold": {expiryTime: types.StringValue("2024-05-20T00:00:00Z"), threshold: types.StringValue("30d"), error: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("SP_API_LWA_CLIENT_SECRET_EXPIRY_TIME", "")
+
+			diags := lwaClientSecretExpiryDiagnostics(SPAPIProviderModel{
+				LWAClientSecretExpiryTime:             test.expiryTime,
+				LWAClientSecretExpiryWarningThreshold: test.threshold,
+			}, now)
+
+			if diags.HasError() != test.error {
+				t.Fatalf("got diagnostics %v", diags)
+			}
+
+			warnings := diags.Warnings()
+			if test.warning == "" && len(warnings) > 0 || test.warning != "" && (len(warnings) != 1 || warnings[0].Summary() != test.warning) {
+				t.Errorf("got warnings %v, want %q", warnings, test.warning)
+			}
+		})
+	}
+}
+
+func TestConfigureInvalidLWAClientSecretExpiryTime(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw}
+	if diags := state.Set(ctx, SPAPIProviderModel{
+		LWAClientID:                           types.StringValue("client"),
+		LWAClientSecret:                       types.StringValue("secret"),
+		RefreshToken:                          types.StringValue("refresh"),
+		Region:                                types.StringNull(),
+		LWAClientSecretExpiryTime:             types.StringValue("2024-05-20"),
+		LWAClientSecretExpiryWarningThreshold: types.StringNull(),
+	}); diags.HasError() {
+		t.Fatal(diags)
+	}
+	config.Raw = state.Raw
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("got no error for an invalid expiry time")
+	}
+
+	if resp.ResourceData != nil || resp.DataSourceData != nil {
+		t.Error("provider data was set despite the invalid configuration")
+	}
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &reportDatasource{}
+	_ datasource.DataSourceWithConfigure        = &reportDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &reportDatasource{}
+)
+
+func NewReportDatasource() datasource.DataSource {
+	return &reportDatasource{}
+}
+
+type reportDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type reportDataSourceModel struct {
+	ID               types.String              `tfsdk:"id"`
+	ReportType       types.String              `tfsdk:"report_type"`
+	MarketplaceIDs   []types.String            `tfsdk:"marketplace_ids"`
+	DataStartTime    types.String              `tfsdk:"data_start_time"`
+	DataEndTime      types.String              `tfsdk:"data_end_time"`
+	ReportOptions    map[string]types.String   `tfsdk:"report_options"`
+	Format           types.String              `tfsdk:"format"`
+	Timeout          types.String              `tfsdk:"timeout"`
+	ProcessingStatus types.String              `tfsdk:"processing_status"`
+	ReportDocumentID types.String              `tfsdk:"report_document_id"`
+	OutputPath       types.String              `tfsdk:"output_path"`
+	OutputSHA256     types.String              `tfsdk:"output_sha256"`
+	Content          types.String              `tfsdk:"content"`
+	Rows             []map[string]types.String `tfsdk:"rows"`
+}
+
+func (d *reportDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *reportDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_report"
+}
+
+func (d *reportDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Requests a report with createReport, waits until it is processed and downloads its document. " +
+			"A new report is requested every time the data source is read.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the report.",
+			},
+			"report_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Report type, e.g. GET_MERCHANT_LISTINGS_ALL_DATA.",
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"data_start_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start of the date and time range of the report data, in RFC 3339 format. Not all report types use it.",
+			},
+			"data_end_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the date and time range of the report data, in RFC 3339 format. Not all report types use it.",
+			},
+			"report_options": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional options of the report type.",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Parse the content as TSV or CSV into rows. The content is only returned as text when unset.",
+				Validators: []validator.String{
+					oneOfValidator{values: reportContentFormats},
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the report to be processed, as a Go duration. Defaults to 15m.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"processing_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"report_document_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"output_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Path of a file to stream the decompressed report document to, instead of returning it as " +
+					"content. Use it for large reports, which should not be kept in the Terraform state. Conflicts with format.",
+			},
+			"output_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 checksum of the file written to output_path.",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "Decompressed content of the report document, converted to UTF-8 from the charset it is served with, unless output_path is set.",
+			},
+			"rows": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+				Description: "Rows of the report keyed by column name, when format is set.",
+			},
+		},
+	}
+}
+
+func (d *reportDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{reportOutputValidator{}}
+}
+
+// reportOutputValidator rejects format together with output_path, as a report
+// streamed to a file is not parsed.
+type reportOutputValidator struct{}
+
+func (v reportOutputValidator) Description(_ context.Context) string {
+	return "Only one of format and output_path can be set."
+}
+
+func (v reportOutputValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v reportOutputValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var format, outputPath types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("format"), &format)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("output_path"), &outputPath)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !format.IsNull() && !outputPath.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Conflicting report attributes",
+			v.Description(ctx),
+		)
+	}
+}
+
+func (d *reportDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state reportDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	specification := reportSpecification{
+		ReportType:     state.ReportType.ValueString(),
+		MarketplaceIDs: make([]string, 0, len(state.MarketplaceIDs)),
+	}
+
+	for _, id := range state.MarketplaceIDs {
+		specification.MarketplaceIDs = append(specification.MarketplaceIDs, id.ValueString())
+	}
+
+	specification.DataStartTime = parseOptionalTime(state.DataStartTime, path.Root("data_start_time"), &resp.Diagnostics)
+	specification.DataEndTime = parseOptionalTime(state.DataEndTime, path.Root("data_end_time"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(state.ReportOptions) > 0 {
+		options := make(map[string]string, len(state.ReportOptions))
+		for key, value := range state.ReportOptions {
+			options[key] = value.ValueString()
+		}
+
+		specification.ReportOptions = options
+	}
+
+	timeout := "15m"
+	if !state.Timeout.IsNull() {
+		timeout = state.Timeout.ValueString()
+	}
+
+	client := newReportsClient(d.sellingPartner, d.region)
+
+	reportID, err := client.CreateReport(ctx, specification)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating report", err.Error())
+		return
+	}
+
+	report, err := waitForReport(ctx, client, reportID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for report processing", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(reportID)
+	state.ProcessingStatus = types.StringValue(report.ProcessingStatus)
+	state.ReportDocumentID = types.StringNull()
+	state.OutputSHA256 = types.StringNull()
+	state.Content = types.StringNull()
+
+	if report.ReportDocumentID != nil {
+		state.ReportDocumentID = types.StringValue(*report.ReportDocumentID)
+
+		// The document of a report that is not DONE describes the failure and
+		// is small, so it is always read.
+		if report.ProcessingStatus == "DONE" && !state.OutputPath.IsNull() {
+			checksum, err := saveReportDocument(ctx, client, *report.ReportDocumentID, state.OutputPath.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Error saving report document", err.Error())
+				return
+			}
+
+			state.OutputSHA256 = types.StringValue(checksum)
+		} else {
+			content, err := getReportDocument(ctx, client, *report.ReportDocumentID)
+			if err != nil {
+				resp.Diagnostics.AddError("Error getting report document", err.Error())
+				return
+			}
+
+			state.Content = types.StringValue(string(content))
+		}
+	}
+
+	// Reports without data are cancelled rather than returned empty.
+	if report.ProcessingStatus == "CANCELLED" {
+		resp.Diagnostics.AddWarning("Report cancelled", fmt.Sprintf("Report %s was cancelled, usually because there is no data to report.", reportID))
+
+		if !state.Format.IsNull() {
+			state.Rows = []map[string]types.String{}
+		}
+
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The document of a FATAL report, if any, describes what went wrong.
+	if report.ProcessingStatus != "DONE" {
+		detail := fmt.Sprintf("Report %s finished with status %s.", reportID, report.ProcessingStatus)
+		if !state.Content.IsNull() {
+			detail += "\n\n" + state.Content.ValueString()
+		}
+
+		resp.Diagnostics.AddError("Report processing failed", detail)
+		return
+	}
+
+	if !state.Format.IsNull() && !state.Content.IsNull() {
+		rows, err := parseReportRows([]byte(state.Content.ValueString()), state.Format.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error parsing report", err.Error())
+			return
+		}
+
+		state.Rows = newReportRowModels(rows)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func newReportRowModels(rows []map[string]string) []map[string]types.String {
+	models := make([]map[string]types.String, 0, len(rows))
+
+	for _, row := range rows {
+		model := make(map[string]types.String, len(row))
+		for column, value := range row {
+			model[column] = types.StringValue(value)
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
+
+// parseOptionalTime parses an optional RFC 3339 attribute, adding an
+// attribute error to diags if it is invalid.
+func parseOptionalTime(value types.String, attributePath path.Path, diags *diag.Diagnostics) *time.Time {
+	if value.IsNull() {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Invalid time", fmt.Sprintf("%q is not in RFC 3339 format: %s", value.ValueString(), err))
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,10 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type reportDocumentModel struct {
+	ID               types.String `tfsdk:"id"`
+	ReportDocumentID types.String `tfsdk:"report_document_id"`
+	OutputPath       types.String `tfsdk:"output_path"`
+	OutputSHA256     types.String `tfsdk:"output_sha256"`
+}
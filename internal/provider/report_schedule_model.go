@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type reportScheduleModel struct {
+	ID                     types.String            `tfsdk:"id"`
+	ReportType             types.String            `tfsdk:"report_type"`
+	MarketplaceIDs         []types.String          `tfsdk:"marketplace_ids"`
+	Period                 types.String            `tfsdk:"period"`
+	NextReportCreationTime types.String            `tfsdk:"next_report_creation_time"`
+	ReportOptions          map[string]types.String `tfsdk:"report_options"`
+	ScheduledReportTime    types.String            `tfsdk:"scheduled_report_time"`
+}
+
+func (m reportScheduleModel) specification() (reportScheduleSpecification, error) {
+	specification := reportScheduleSpecification{
+		ReportType:     m.ReportType.ValueString(),
+		Period:         m.Period.ValueString(),
+		MarketplaceIDs: make([]string, 0, len(m.MarketplaceIDs)),
+	}
+
+	for _, id := range m.MarketplaceIDs {
+		specification.MarketplaceIDs = append(specification.MarketplaceIDs, id.ValueString())
+	}
+
+	if !m.NextReportCreationTime.IsNull() {
+		t, err := time.Parse(time.RFC3339, m.NextReportCreationTime.ValueString())
+		if err != nil {
+			return specification, err
+		}
+
+		specification.NextReportCreationTime = &t
+	}
+
+	if len(m.ReportOptions) > 0 {
+		options := make(map[string]string, len(m.ReportOptions))
+		for key, value := range m.ReportOptions {
+			options[key] = value.ValueString()
+		}
+
+		specification.ReportOptions = options
+	}
+
+	return specification, nil
+}
+
+// setSchedule copies a report schedule into the model. The order of the
+// marketplace IDs is kept if only the order differs. next_report_creation_time
+// is only used to create the schedule and is left as is.
+func (m *reportScheduleModel) setSchedule(schedule *reportSchedule) {
+	m.ID = types.StringValue(schedule.ReportScheduleID)
+	m.ReportType = types.StringValue(schedule.ReportType)
+	m.Period = types.StringValue(schedule.Period)
+	m.ScheduledReportTime = optionalTimeValue(schedule.NextReportCreationTime)
+
+	marketplaceIDs := schedule.MarketplaceIDs
+
+	current := make([]string, 0, len(m.MarketplaceIDs))
+	for _, id := range m.MarketplaceIDs {
+		current = append(current, id.ValueString())
+	}
+
+	slices.Sort(current)
+	sorted := slices.Clone(marketplaceIDs)
+	slices.Sort(sorted)
+
+	if !slices.Equal(current, sorted) {
+		m.MarketplaceIDs = make([]types.String, 0, len(marketplaceIDs))
+		for _, id := range marketplaceIDs {
+			m.MarketplaceIDs = append(m.MarketplaceIDs, types.StringValue(id))
+		}
+	}
+
+	options := schedule.ReportOptions
+
+	if len(options) == 0 && len(m.ReportOptions) == 0 {
+		return
+	}
+
+	m.ReportOptions = make(map[string]types.String, len(options))
+	for key, value := range options {
+		m.ReportOptions[key] = types.StringValue(value)
+	}
+}
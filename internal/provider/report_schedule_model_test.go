@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReportScheduleModelSetSchedule(t *testing.T) {
+	next := time.Date(2024, 5, 1, 6, 0, 0, 0, time.UTC)
+
+	model := reportScheduleModel{
+		ID:                     types.StringValue("50012345678"),
+		MarketplaceIDs:         []types.String{types.StringValue("A1PA6795UKMFR9"), types.StringValue("A1F83G8C2ARO7P")},
+		NextReportCreationTime: types.StringValue("2024-04-01T06:00:00Z"),
+	}
+
+	model.setSchedule(&reportSchedule{
+		ReportScheduleID:       "50012345678",
+		ReportType:             "GET_FBA_MYI_UNSUPPRESSED_INVENTORY_DATA",
+		Period:                 "P1D",
+		MarketplaceIDs:         []string{"A1F83G8C2ARO7P", "A1PA6795UKMFR9"},
+		NextReportCreationTime: &next,
+	})
+
+	if got := model.MarketplaceIDs[0].ValueString(); got != "A1PA6795UKMFR9" {
+		t.Errorf("marketplace IDs were reordered, first is %s", got)
+	}
+
+	if model.ReportOptions != nil {
+		t.Errorf("report options = %v, want null", model.ReportOptions)
+	}
+
+	if got := model.ScheduledReportTime.ValueString(); got != "2024-05-01T06:00:00Z" {
+		t.Errorf("scheduled report time = %s", got)
+	}
+
+	if got := model.NextReportCreationTime.ValueString(); got != "2024-04-01T06:00:00Z" {
+		t.Errorf("next report creation time changed to %s", got)
+	}
+
+	// A schedule changed outside of Terraform is detected.
+	model.setSchedule(&reportSchedule{
+		ReportScheduleID: "50012345678",
+		ReportType:       "GET_FBA_MYI_UNSUPPRESSED_INVENTORY_DATA",
+		Period:           "P7D",
+		MarketplaceIDs:   []string{"A1PA6795UKMFR9"},
+		ReportOptions:    map[string]string{"custom": "true"},
+	})
+
+	if len(model.MarketplaceIDs) != 1 || model.Period.ValueString() != "P7D" {
+		t.Errorf("got marketplace IDs %v and period %s", model.MarketplaceIDs, model.Period)
+	}
+
+	if got := model.ReportOptions["custom"].ValueString(); got != "true" {
+		t.Errorf("report option custom = %q", got)
+	}
+
+	if !model.ScheduledReportTime.IsNull() {
+		t.Errorf("scheduled report time = %s, want null", model.ScheduledReportTime)
+	}
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &reportScheduleResource{}
+	_ resource.ResourceWithConfigure      = &reportScheduleResource{}
+	_ resource.ResourceWithImportState    = &reportScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &reportScheduleResource{}
+)
+
+// NewReportScheduleResource is a helper function to simplify the provider implementation.
+func NewReportScheduleResource() resource.Resource {
+	return &reportScheduleResource{}
+}
+
+// reportScheduleResource is the resource implementation.
+type reportScheduleResource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+// Metadata returns the resource type name.
+func (r *reportScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_report_schedule"
+}
+
+func (r *reportScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.sellingPartner = providerData.SellingPartner
+	r.region = providerData.Region
+}
+
+// Schema defines the schema for the resource.
+func (r *reportScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Schedules a report to be created periodically. Report schedules cannot be changed, so any change " +
+			"cancels the schedule and creates a new one. Amazon replaces an existing schedule for the same report type " +
+			"and marketplaces.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the report schedule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"report_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Report type, e.g. GET_FBA_MYI_UNSUPPRESSED_INVENTORY_DATA.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"period": schema.StringAttribute{
+				Required:    true,
+				Description: "How often a report is created, as one of the ISO 8601 periods " + strings.Join(reportSchedulePeriods, ", ") + ".",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"next_report_creation_time": schema.StringAttribute{
+				Optional: true,
+				Description: "When the first report is created, in RFC 3339 format. Only used to create the schedule. " +
+					"Changing it creates a new schedule, except when it was unset before, e.g. after an import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessPriorNull,
+						"Changing the value requires a new schedule, unless it was unset before.",
+						"Changing the value requires a new schedule, unless it was unset before.",
+					),
+				},
+			},
+			"report_options": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional options of the report type.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"scheduled_report_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the schedule will create its next report.",
+			},
+		},
+	}
+}
+
+func (r *reportScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var period, nextReportCreationTime types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("period"), &period)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("next_report_creation_time"), &nextReportCreationTime)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !period.IsNull() && !period.IsUnknown() && !slices.Contains(reportSchedulePeriods, period.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("period"),
+			"Invalid report schedule period",
+			fmt.Sprintf("%q is not one of %s.", period.ValueString(), strings.Join(reportSchedulePeriods, ", ")),
+		)
+	}
+
+	if !nextReportCreationTime.IsNull() && !nextReportCreationTime.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, nextReportCreationTime.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("next_report_creation_time"),
+				"Invalid time",
+				fmt.Sprintf("%q is not in RFC 3339 format: %s", nextReportCreationTime.ValueString(), err),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *reportScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan reportScheduleModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	specification, err := plan.specification()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("next_report_creation_time"), "Invalid time", err.Error())
+		return
+	}
+
+	client := newReportsClient(r.sellingPartner, r.region)
+
+	reportScheduleID, err := client.CreateReportSchedule(ctx, specification)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating report schedule", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(reportScheduleID)
+	plan.ScheduledReportTime = types.StringNull()
+
+	// The schedule exists at this point, so an error would only taint it.
+	schedule, err := client.GetReportSchedule(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error getting report schedule",
+			fmt.Sprintf("Report schedule %s was created, but reading it failed: %s. scheduled_report_time is read on the next refresh.", reportScheduleID, err),
+		)
+	} else {
+		plan.ScheduledReportTime = optionalTimeValue(schedule.NextReportCreationTime)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *reportScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state reportScheduleModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newReportsClient(r.sellingPartner, r.region)
+
+	schedule, err := client.GetReportSchedule(ctx, state.ID.ValueString())
+	if isSPAPINotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting report schedule", err.Error())
+		return
+	}
+
+	state.setSchedule(schedule)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every argument requires a replacement, except next_report_creation_time
+// after an import, which is only kept in the state.
+func (r *reportScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state reportScheduleModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.NextReportCreationTime = plan.NextReportCreationTime
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *reportScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state reportScheduleModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newReportsClient(r.sellingPartner, r.region)
+
+	// A schedule that was replaced by one for the same report type and
+	// marketplaces no longer exists.
+	err := client.CancelReportSchedule(ctx, state.ID.ValueString())
+	if isSPAPINotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error cancelling report schedule", err.Error())
+		return
+	}
+}
+
+// requiresReplaceUnlessPriorNull requires a replacement unless the prior
+// value is null. An imported schedule has no next_report_creation_time, as
+// Amazon only returns when the next report is created, and setting it in the
+// configuration should not recreate the schedule.
+func requiresReplaceUnlessPriorNull(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// ImportState imports a report schedule by its ID.
+func (r *reportScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReportScheduleNextReportCreationTimeRequiresReplace(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&reportScheduleResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	attribute := schemaResp.Schema.Attributes["next_report_creation_time"].(schema.StringAttribute)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, reportScheduleModel{
+		ID:                     types.StringValue("50012345678"),
+		ReportType:             types.StringValue("GET_FBA_MYI_UNSUPPRESSED_INVENTORY_DATA"),
+		MarketplaceIDs:         []types.String{types.StringValue("ATVPDKIKX0DER")},
+		Period:                 types.StringValue("P1D"),
+		NextReportCreationTime: types.StringNull(),
+		ScheduledReportTime:    types.StringNull(),
+	}); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	tests := map[string]struct {
+		prior types.String
+		want  bool
+	}{
+		"imported": {prior: types.StringNull(), want: false},
+		"changed":  {prior: types.StringValue("2024-05-01T06:00:00Z"), want: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value := types.StringValue("2024-06-01T06:00:00Z")
+			req := planmodifier.StringRequest{
+				State:       state,
+				Plan:        tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+				StateValue:  test.prior,
+				ConfigValue: value,
+				PlanValue:   value,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: value}
+
+			for _, modifier := range attribute.PlanModifiers {
+				modifier.PlanModifyString(ctx, req, resp)
+			}
+
+			if resp.RequiresReplace != test.want {
+				t.Errorf("RequiresReplace = %t, want %t", resp.RequiresReplace, test.want)
+			}
+		})
+	}
+}
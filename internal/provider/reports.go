@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// reportProcessingDoneStatuses are the processingStatus values of a report
+// that will not change anymore.
+var reportProcessingDoneStatuses = []string{"DONE", "FATAL", "CANCELLED"}
+
+// reportContentFormats are the formats report content can be parsed as.
+var reportContentFormats = []string{"TSV", "CSV"}
+
+// reportsClient calls the Reports API (version 2021-06-30). The SDK only
+// generates a client for the retired 2020-09-04 version.
+type reportsClient struct {
+	sellingPartner *sp.SellingPartner
+	endpoint       string
+}
+
+func newReportsClient(sellingPartner *sp.SellingPartner, region spapiRegion) *reportsClient {
+	return &reportsClient{
+		sellingPartner: sellingPartner,
+		endpoint:       region.Endpoint,
+	}
+}
+
+type report struct {
+	ReportID            string     `json:"reportId"`
+	ReportType          string     `json:"reportType"`
+	MarketplaceIDs      []string   `json:"marketplaceIds"`
+	DataStartTime       *time.Time `json:"dataStartTime"`
+	DataEndTime         *time.Time `json:"dataEndTime"`
+	ReportScheduleID    *string    `json:"reportScheduleId"`
+	CreatedTime         time.Time  `json:"createdTime"`
+	ProcessingStatus    string     `json:"processingStatus"`
+	ProcessingStartTime *time.Time `json:"processingStartTime"`
+	ProcessingEndTime   *time.Time `json:"processingEndTime"`
+	ReportDocumentID    *string    `json:"reportDocumentId"`
+}
+
+type reportSpecification struct {
+	ReportType     string            `json:"reportType"`
+	MarketplaceIDs []string          `json:"marketplaceIds"`
+	DataStartTime  *time.Time        `json:"dataStartTime,omitempty"`
+	DataEndTime    *time.Time        `json:"dataEndTime,omitempty"`
+	ReportOptions  map[string]string `json:"reportOptions,omitempty"`
+}
+
+type reportDocument struct {
+	ReportDocumentID     string `json:"reportDocumentId"`
+	URL                  string `json:"url"`
+	CompressionAlgorithm string `json:"compressionAlgorithm"`
+}
+
+type reportSchedule struct {
+	ReportScheduleID       string            `json:"reportScheduleId"`
+	ReportType             string            `json:"reportType"`
+	MarketplaceIDs         []string          `json:"marketplaceIds"`
+	ReportOptions          map[string]string `json:"reportOptions"`
+	Period                 string            `json:"period"`
+	NextReportCreationTime *time.Time        `json:"nextReportCreationTime"`
+}
+
+type reportScheduleSpecification struct {
+	ReportType             string            `json:"reportType"`
+	MarketplaceIDs         []string          `json:"marketplaceIds"`
+	ReportOptions          map[string]string `json:"reportOptions,omitempty"`
+	Period                 string            `json:"period"`
+	NextReportCreationTime *time.Time        `json:"nextReportCreationTime,omitempty"`
+}
+
+// reportsFilter selects the reports listReports returns. Unset fields do not
+// filter.
+type reportsFilter struct {
+	ReportTypes        []string
+	ProcessingStatuses []string
+	MarketplaceIDs     []string
+	PageSize           int
+	CreatedSince       *time.Time
+	CreatedUntil       *time.Time
+}
+
+func (f reportsFilter) query() url.Values {
+	query := url.Values{}
+
+	if len(f.ReportTypes) > 0 {
+		query.Set("reportTypes", strings.Join(f.ReportTypes, ","))
+	}
+
+	if len(f.ProcessingStatuses) > 0 {
+		query.Set("processingStatuses", strings.Join(f.ProcessingStatuses, ","))
+	}
+
+	if len(f.MarketplaceIDs) > 0 {
+		query.Set("marketplaceIds", strings.Join(f.MarketplaceIDs, ","))
+	}
+
+	if f.PageSize > 0 {
+		query.Set("pageSize", strconv.Itoa(f.PageSize))
+	}
+
+	if f.CreatedSince != nil {
+		query.Set("createdSince", f.CreatedSince.Format(time.RFC3339))
+	}
+
+	if f.CreatedUntil != nil {
+		query.Set("createdUntil", f.CreatedUntil.Format(time.RFC3339))
+	}
+
+	return query
+}
+
+// CreateReport requests a report, returning the report ID.
+func (c *reportsClient) CreateReport(ctx context.Context, specification reportSpecification) (string, error) {
+	var result struct {
+		ReportID string `json:"reportId"`
+	}
+
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodPost, c.endpoint+"/reports/2021-06-30/reports", nil, specification, &result); err != nil {
+		return "", err
+	}
+
+	return result.ReportID, nil
+}
+
+// GetReport returns a report, or an spapiResponseError when it does not
+// exist.
+func (c *reportsClient) GetReport(ctx context.Context, reportID string) (*report, error) {
+	var result report
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/reports/2021-06-30/reports/"+url.PathEscape(reportID), nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetReports returns a page of reports and the token of the next page, if
+// any. query is either a filter or just the nextToken of the previous page.
+func (c *reportsClient) GetReports(ctx context.Context, query url.Values) ([]report, string, error) {
+	var result struct {
+		Reports   []report `json:"reports"`
+		NextToken string   `json:"nextToken"`
+	}
+
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/reports/2021-06-30/reports", query, nil, &result); err != nil {
+		return nil, "", err
+	}
+
+	return result.Reports, result.NextToken, nil
+}
+
+// GetReportDocument returns where a report document can be downloaded from
+// and how it is compressed.
+func (c *reportsClient) GetReportDocument(ctx context.Context, documentID string) (*reportDocument, error) {
+	var document reportDocument
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/reports/2021-06-30/documents/"+url.PathEscape(documentID), nil, nil, &document); err != nil {
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+// CreateReportSchedule creates a report schedule, returning its ID. An
+// existing schedule for the same report type and marketplaces is replaced.
+func (c *reportsClient) CreateReportSchedule(ctx context.Context, specification reportScheduleSpecification) (string, error) {
+	var result struct {
+		ReportScheduleID string `json:"reportScheduleId"`
+	}
+
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodPost, c.endpoint+"/reports/2021-06-30/schedules", nil, specification, &result); err != nil {
+		return "", err
+	}
+
+	return result.ReportScheduleID, nil
+}
+
+// GetReportSchedule returns a report schedule, or an spapiResponseError when
+// it does not exist.
+func (c *reportsClient) GetReportSchedule(ctx context.Context, reportScheduleID string) (*reportSchedule, error) {
+	var schedule reportSchedule
+	if err := doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodGet, c.endpoint+"/reports/2021-06-30/schedules/"+url.PathEscape(reportScheduleID), nil, nil, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// CancelReportSchedule cancels a report schedule.
+func (c *reportsClient) CancelReportSchedule(ctx context.Context, reportScheduleID string) error {
+	return doSPAPIRequest(ctx, c.sellingPartner.AuthorizeRequest, http.MethodDelete, c.endpoint+"/reports/2021-06-30/schedules/"+url.PathEscape(reportScheduleID), nil, nil, nil)
+}
+
+// waitForReport polls GetReport until the report is done processing or the
+// timeout expires.
+func waitForReport(ctx context.Context, client *reportsClient, reportID string, timeout string) (*report, error) {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timeout %q: %w", timeout, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	// getReport allows two requests per second after a burst of 15, but most
+	// reports take minutes, so there is no point in polling that often.
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		report, err := client.GetReport(ctx, reportID)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(reportProcessingDoneStatuses, report.ProcessingStatus) {
+			return report, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for report processing", map[string]interface{}{"report_id": reportID, "processing_status": report.ProcessingStatus})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("report %s did not finish processing within %s", reportID, duration)
+		case <-ticker.C:
+		}
+	}
+}
+
+// getReportDocument downloads and decompresses a report document.
+func getReportDocument(ctx context.Context, client *reportsClient, documentID string) ([]byte, error) {
+	document, err := client.GetReportDocument(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("getting report document: %w", err)
+	}
+
+	return fetchDocument(ctx, document.URL, document.CompressionAlgorithm)
+}
+
+// saveReportDocument streams a report document to outputPath without holding
+// it in memory, returning the SHA-256 checksum of the decompressed content.
+func saveReportDocument(ctx context.Context, client *reportsClient, documentID string, outputPath string) (string, error) {
+	document, err := client.GetReportDocument(ctx, documentID)
+	if err != nil {
+		return "", fmt.Errorf("getting report document: %w", err)
+	}
+
+	return saveDocument(ctx, document.URL, document.CompressionAlgorithm, outputPath)
+}
+
+// parseReportRows parses tab-separated or comma-separated report content into
+// rows keyed by the column names of the first line. Tab-separated reports do
+// not quote their fields, so quotes are kept as is. Missing trailing fields
+// are left out of a row.
+func parseReportRows(content []byte, format string) ([]map[string]string, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	var records [][]string
+
+	if format == "TSV" {
+		for _, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSuffix(line, "\r")
+			if line != "" {
+				records = append(records, strings.Split(line, "\t"))
+			}
+		}
+	} else {
+		reader := csv.NewReader(bytes.NewReader(content))
+		reader.FieldsPerRecord = -1
+		reader.LazyQuotes = true
+
+		var err error
+		if records, err = reader.ReadAll(); err != nil {
+			return nil, fmt.Errorf("parsing %s report: %w", format, err)
+		}
+	}
+
+	rows := []map[string]string{}
+	if len(records) == 0 {
+		return rows, nil
+	}
+
+	header := records[0]
+
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(header))
+		for i, field := range record {
+			if i < len(header) {
+				row[header[i]] = field
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// reportSchedulePeriods are the ISO 8601 periods a report schedule can use.
+var reportSchedulePeriods = []string{
+	"PT5M", "PT15M", "PT30M", "PT1H", "PT2H", "PT4H", "PT8H", "PT12H",
+	"P1D", "P2D", "P3D", "PT84H", "P7D", "P14D", "P15D", "P18D", "P30D", "P1M",
+}
+
+// listReports returns all reports matching filter, following nextToken
+// through every page. getReports allows a burst of 10 requests and then one
+// every 45 seconds, so long listings are throttled. doSPAPIRequest retries a
+// throttled page after the delay its x-amzn-RateLimit-Limit header asks for,
+// so pages are not paced here.
+func listReports(ctx context.Context, client *reportsClient, filter reportsFilter) ([]report, error) {
+	list := []report{}
+	query := filter.query()
+
+	for {
+		page, nextToken, err := client.GetReports(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("getting reports: %w", err)
+		}
+
+		list = append(list, page...)
+
+		if nextToken == "" {
+			return list, nil
+		}
+
+		// nextToken must be the only parameter of the following requests.
+		query = url.Values{"nextToken": {nextToken}}
+	}
+}
+
+// latestReportWithDocument returns the most recently created report that is
+// done and has a document, or nil if there is none.
+func latestReportWithDocument(list []report) *report {
+	var latest *report
+
+	for i := range list {
+		report := &list[i]
+		if report.ProcessingStatus != "DONE" || report.ReportDocumentID == nil {
+			continue
+		}
+
+		if latest == nil || report.CreatedTime.After(latest.CreatedTime) {
+			latest = report
+		}
+	}
+
+	return latest
+}
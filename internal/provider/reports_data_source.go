@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &reportsDatasource{}
+	_ datasource.DataSourceWithConfigure = &reportsDatasource{}
+)
+
+// reportProcessingStatuses are the processingStatus values of a report.
+var reportProcessingStatuses = []string{"CANCELLED", "DONE", "FATAL", "IN_PROGRESS", "IN_QUEUE"}
+
+func NewReportsDatasource() datasource.DataSource {
+	return &reportsDatasource{}
+}
+
+type reportsDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+type reportsDataSourceModel struct {
+	ReportTypes            []types.String     `tfsdk:"report_types"`
+	ProcessingStatuses     []types.String     `tfsdk:"processing_statuses"`
+	MarketplaceIDs         []types.String     `tfsdk:"marketplace_ids"`
+	CreatedSince           types.String       `tfsdk:"created_since"`
+	CreatedUntil           types.String       `tfsdk:"created_until"`
+	DownloadLatest         types.Bool         `tfsdk:"download_latest"`
+	Reports                []reportEntryModel `tfsdk:"reports"`
+	LatestReportID         types.String       `tfsdk:"latest_report_id"`
+	LatestReportDocumentID types.String       `tfsdk:"latest_report_document_id"`
+	LatestContent          types.String       `tfsdk:"latest_content"`
+}
+
+type reportEntryModel struct {
+	ReportID         types.String   `tfsdk:"report_id"`
+	ReportType       types.String   `tfsdk:"report_type"`
+	ProcessingStatus types.String   `tfsdk:"processing_status"`
+	MarketplaceIDs   []types.String `tfsdk:"marketplace_ids"`
+	CreatedTime      types.String   `tfsdk:"created_time"`
+	DataStartTime    types.String   `tfsdk:"data_start_time"`
+	DataEndTime      types.String   `tfsdk:"data_end_time"`
+	ReportScheduleID types.String   `tfsdk:"report_schedule_id"`
+	ReportDocumentID types.String   `tfsdk:"report_document_id"`
+}
+
+func (d *reportsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *reportsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reports"
+}
+
+func (d *reportsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing reports with getReports, such as the settlement reports Amazon creates on its own. " +
+			"Reports are kept for 90 days.",
+		Attributes: map[string]schema.Attribute{
+			"report_types": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Report types to list, e.g. GET_V2_SETTLEMENT_REPORT_DATA_FLAT_FILE_V2.",
+			},
+			"processing_statuses": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Processing statuses to list, any of " + strings.Join(reportProcessingStatuses, ", ") + ".",
+			},
+			"marketplace_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "List reports for any of these marketplaces.",
+			},
+			"created_since": schema.StringAttribute{
+				Optional:    true,
+				Description: "Earliest creation time of the reports, in RFC 3339 format. Defaults to 90 days ago.",
+			},
+			"created_until": schema.StringAttribute{
+				Optional:    true,
+				Description: "Latest creation time of the reports, in RFC 3339 format. Defaults to now.",
+			},
+			"download_latest": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Download the document of the most recently created report that is DONE into latest_content.",
+			},
+			"reports": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"report_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"report_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"processing_status": schema.StringAttribute{
+							Computed: true,
+						},
+						"marketplace_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"created_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"data_start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"data_end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"report_schedule_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"report_document_id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"latest_report_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the most recently created report that is DONE.",
+			},
+			"latest_report_document_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"latest_content": schema.StringAttribute{
+				Computed:    true,
+				Description: "Decompressed content of the latest report document, when download_latest is set.",
+			},
+		},
+	}
+}
+
+func (d *reportsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state reportsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := reportsFilter{
+		ReportTypes: stringValues(state.ReportTypes),
+		PageSize:    100,
+	}
+
+	if len(state.ProcessingStatuses) > 0 {
+		for _, status := range state.ProcessingStatuses {
+			if !slices.Contains(reportProcessingStatuses, status.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("processing_statuses"),
+					"Invalid processing status",
+					fmt.Sprintf("%q is not one of %s.", status.ValueString(), strings.Join(reportProcessingStatuses, ", ")),
+				)
+			}
+		}
+
+		filter.ProcessingStatuses = stringValues(state.ProcessingStatuses)
+	}
+
+	if len(state.MarketplaceIDs) > 0 {
+		filter.MarketplaceIDs = stringValues(state.MarketplaceIDs)
+	}
+
+	filter.CreatedSince = parseOptionalTime(state.CreatedSince, path.Root("created_since"), &resp.Diagnostics)
+	filter.CreatedUntil = parseOptionalTime(state.CreatedUntil, path.Root("created_until"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newReportsClient(d.sellingPartner, d.region)
+
+	list, err := listReports(ctx, client, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting reports", err.Error())
+		return
+	}
+
+	state.Reports = make([]reportEntryModel, 0, len(list))
+
+	for _, report := range list {
+		entry := reportEntryModel{
+			ReportID:         types.StringValue(report.ReportID),
+			ReportType:       types.StringValue(report.ReportType),
+			ProcessingStatus: types.StringValue(report.ProcessingStatus),
+			MarketplaceIDs:   []types.String{},
+			CreatedTime:      optionalTimeValue(&report.CreatedTime),
+			DataStartTime:    optionalTimeValue(report.DataStartTime),
+			DataEndTime:      optionalTimeValue(report.DataEndTime),
+			ReportScheduleID: types.StringPointerValue(report.ReportScheduleID),
+			ReportDocumentID: types.StringPointerValue(report.ReportDocumentID),
+		}
+
+		for _, id := range report.MarketplaceIDs {
+			entry.MarketplaceIDs = append(entry.MarketplaceIDs, types.StringValue(id))
+		}
+
+		state.Reports = append(state.Reports, entry)
+	}
+
+	state.LatestReportID = types.StringNull()
+	state.LatestReportDocumentID = types.StringNull()
+	state.LatestContent = types.StringNull()
+
+	if latest := latestReportWithDocument(list); latest != nil {
+		state.LatestReportID = types.StringValue(latest.ReportID)
+		state.LatestReportDocumentID = types.StringValue(*latest.ReportDocumentID)
+
+		if state.DownloadLatest.ValueBool() {
+			content, err := getReportDocument(ctx, client, *latest.ReportDocumentID)
+			if err != nil {
+				resp.Diagnostics.AddError("Error getting report document", err.Error())
+				return
+			}
+
+			state.LatestContent = types.StringValue(string(content))
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// stringValues converts a list attribute into a string slice.
+func stringValues(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+
+	return result
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+)
+
+func TestParseReportRows(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		format  string
+		want    []map[string]string
+	}{
+		"tsv": {
+			content: "\xef\xbb\xbfitem-name\tseller-sku\tprice\r\n" +
+				"Mug \"Classic\" 12oz\tMUG-1\t9.99\r\n" +
+				"Plate\tPLATE-1\n",
+			format: "TSV",
+			want: []map[string]string{
+				{"item-name": `Mug "Classic" 12oz`, "seller-sku": "MUG-1", "price": "9.99"},
+				{"item-name": "Plate", "seller-sku": "PLATE-1"},
+			},
+		},
+		"csv": {
+			content: "sku,title,quantity\n" +
+				"MUG-1,\"Mug, large\",3\n",
+			format: "CSV",
+			want: []map[string]string{
+				{"sku": "MUG-1", "title": "Mug, large", "quantity": "3"},
+			},
+		},
+		"empty": {
+			content: "",
+			format:  "TSV",
+			want:    []map[string]string{},
+		},
+		"header only": {
+			content: "sku\tquantity\n",
+			format:  "TSV",
+			want:    []map[string]string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rows, err := parseReportRows([]byte(test.content), test.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(rows, test.want) {
+				t.Errorf("got %v, want %v", rows, test.want)
+			}
+		})
+	}
+}
+
+func TestLatestReportWithDocument(t *testing.T) {
+	documentID := func(id string) *string { return &id }
+	day := func(d int) time.Time { return time.Date(2024, 4, d, 0, 0, 0, 0, time.UTC) }
+
+	list := []report{
+		{ReportID: "1", ProcessingStatus: "DONE", CreatedTime: day(1), ReportDocumentID: documentID("doc-1")},
+		{ReportID: "2", ProcessingStatus: "DONE", CreatedTime: day(8), ReportDocumentID: documentID("doc-2")},
+		{ReportID: "3", ProcessingStatus: "FATAL", CreatedTime: day(9), ReportDocumentID: documentID("doc-3")},
+		{ReportID: "4", ProcessingStatus: "IN_PROGRESS", CreatedTime: day(10)},
+		{ReportID: "5", ProcessingStatus: "DONE", CreatedTime: day(5), ReportDocumentID: documentID("doc-5")},
+	}
+
+	latest := latestReportWithDocument(list)
+	if latest == nil || latest.ReportID != "2" {
+		t.Errorf("got %+v, want report 2", latest)
+	}
+
+	if latest := latestReportWithDocument(list[2:4]); latest != nil {
+		t.Errorf("got %+v, want none", latest)
+	}
+}
+
+func TestReportsFilterQuery(t *testing.T) {
+	since := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	query := reportsFilter{
+		ReportTypes:    []string{"GET_MERCHANT_LISTINGS_ALL_DATA", "GET_FLAT_FILE_OPEN_LISTINGS_DATA"},
+		MarketplaceIDs: []string{"ATVPDKIKX0DER"},
+		PageSize:       100,
+		CreatedSince:   &since,
+	}.query()
+
+	want := "createdSince=2024-04-01T00%3A00%3A00Z&marketplaceIds=ATVPDKIKX0DER&pageSize=100" +
+		"&reportTypes=GET_MERCHANT_LISTINGS_ALL_DATA%2CGET_FLAT_FILE_OPEN_LISTINGS_DATA"
+	if got := query.Encode(); got != want {
+		t.Errorf("query = %s, want %s", got, want)
+	}
+}
+
+func TestListReportsRetriesThrottledPages(t *testing.T) {
+	requests := 0
+
+	transport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	http.DefaultTransport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"access_token": "Atza|test", "expires_in": 3600}`
+		status := http.StatusOK
+		header := http.Header{}
+
+		if req.URL.Path == "/reports/2021-06-30/reports" {
+			requests++
+
+			switch {
+			case requests == 2:
+				status = http.StatusTooManyRequests
+				header.Set("x-amzn-RateLimit-Limit", "1000")
+				body = `{"errors": [{"code": "QuotaExceeded", "message": "You exceeded your quota for the requested resource."}]}`
+			case req.URL.Query().Get("nextToken") == "":
+				body = `{"reports": [{"reportId": "1", "processingStatus": "DONE"}], "nextToken": "page-2"}`
+			default:
+				body = `{"reports": [{"reportId": "2", "processingStatus": "DONE"}]}`
+			}
+		}
+
+		return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	sellingPartner, err := sp.NewSellingPartner(&sp.Config{ClientID: "client", ClientSecret: "secret", RefreshToken: "refresh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := newReportsClient(sellingPartner, spapiRegion{Endpoint: "https://sellingpartnerapi-na.amazon.com"})
+
+	list, err := listReports(context.Background(), client, reportsFilter{ReportTypes: []string{"GET_MERCHANT_LISTINGS_ALL_DATA"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list) != 2 || list[0].ReportID != "1" || list[1].ReportID != "2" {
+		t.Errorf("got reports %+v, want both pages", list)
+	}
+
+	if requests != 3 {
+		t.Errorf("got %d getReports requests, want 3 with the throttled page retried", requests)
+	}
+}
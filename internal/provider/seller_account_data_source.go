@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &sellerAccountDatasource{}
+	_ datasource.DataSourceWithConfigure = &sellerAccountDatasource{}
+)
+
+func NewSellerAccountDatasource() datasource.DataSource {
+	return &sellerAccountDatasource{}
+}
+
+type sellerAccountDatasource struct {
+	sellingPartner *sp.SellingPartner
+	region         spapiRegion
+}
+
+// sellerAccount mirrors the Account object of the Sellers API getAccount
+// operation, which the SDK does not generate a client for.
+type sellerAccount struct {
+	MarketplaceParticipationList []struct {
+		Marketplace struct {
+			ID string `json:"id"`
+		} `json:"marketplace"`
+	} `json:"marketplaceParticipationList"`
+	BusinessType string `json:"businessType"`
+	SellingPlan  string `json:"sellingPlan"`
+	Business     *struct {
+		Name                           string        `json:"name"`
+		RegisteredBusinessAddress      sellerAddress `json:"registeredBusinessAddress"`
+		CompanyRegistrationNumber      *string       `json:"companyRegistrationNumber"`
+		CompanyTaxIdentificationNumber *string       `json:"companyTaxIdentificationNumber"`
+		NonLatinName                   *string       `json:"nonLatinName"`
+	} `json:"business"`
+	PrimaryContact *struct {
+		Name         string        `json:"name"`
+		Address      sellerAddress `json:"address"`
+		NonLatinName *string       `json:"nonLatinName"`
+	} `json:"primaryContact"`
+}
+
+type sellerAddress struct {
+	AddressLine1        string  `json:"addressLine1"`
+	AddressLine2        *string `json:"addressLine2"`
+	City                *string `json:"city"`
+	StateOrProvinceCode *string `json:"stateOrProvinceCode"`
+	PostalCode          *string `json:"postalCode"`
+	CountryCode         string  `json:"countryCode"`
+}
+
+type sellerAccountDataSourceModel struct {
+	MarketplaceIDs []types.String                    `tfsdk:"marketplace_ids"`
+	BusinessType   types.String                      `tfsdk:"business_type"`
+	SellingPlan    types.String                      `tfsdk:"selling_plan"`
+	Business       *sellerAccountBusinessModel       `tfsdk:"business"`
+	PrimaryContact *sellerAccountPrimaryContactModel `tfsdk:"primary_contact"`
+}
+
+type sellerAccountBusinessModel struct {
+	Name                           types.String       `tfsdk:"name"`
+	NonLatinName                   types.String       `tfsdk:"non_latin_name"`
+	CompanyRegistrationNumber      types.String       `tfsdk:"company_registration_number"`
+	CompanyTaxIdentificationNumber types.String       `tfsdk:"company_tax_identification_number"`
+	RegisteredBusinessAddress      sellerAddressModel `tfsdk:"registered_business_address"`
+}
+
+type sellerAccountPrimaryContactModel struct {
+	Name         types.String       `tfsdk:"name"`
+	NonLatinName types.String       `tfsdk:"non_latin_name"`
+	Address      sellerAddressModel `tfsdk:"address"`
+}
+
+type sellerAddressModel struct {
+	AddressLine1        types.String `tfsdk:"address_line1"`
+	AddressLine2        types.String `tfsdk:"address_line2"`
+	City                types.String `tfsdk:"city"`
+	StateOrProvinceCode types.String `tfsdk:"state_or_province_code"`
+	PostalCode          types.String `tfsdk:"postal_code"`
+	CountryCode         types.String `tfsdk:"country_code"`
+}
+
+func newSellerAddressModel(address sellerAddress) sellerAddressModel {
+	return sellerAddressModel{
+		AddressLine1:        types.StringValue(address.AddressLine1),
+		AddressLine2:        types.StringPointerValue(address.AddressLine2),
+		City:                types.StringPointerValue(address.City),
+		StateOrProvinceCode: types.StringPointerValue(address.StateOrProvinceCode),
+		PostalCode:          types.StringPointerValue(address.PostalCode),
+		CountryCode:         types.StringValue(address.CountryCode),
+	}
+}
+
+func sellerAddressSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"address_line1": schema.StringAttribute{
+			Computed: true,
+		},
+		"address_line2": schema.StringAttribute{
+			Computed: true,
+		},
+		"city": schema.StringAttribute{
+			Computed: true,
+		},
+		"state_or_province_code": schema.StringAttribute{
+			Computed: true,
+		},
+		"postal_code": schema.StringAttribute{
+			Computed: true,
+		},
+		"country_code": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func (d *sellerAccountDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*spapiProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spapiProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sellingPartner = providerData.SellingPartner
+	d.region = providerData.Region
+}
+
+func (d *sellerAccountDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_seller_account"
+}
+
+func (d *sellerAccountDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"marketplace_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"business_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Business type of the seller, e.g. INDIVIDUAL, SOLE_PROPRIETORSHIP or PRIVATE_LIMITED.",
+			},
+			"selling_plan": schema.StringAttribute{
+				Computed:    true,
+				Description: "Selling plan of the seller, PROFESSIONAL or INDIVIDUAL.",
+			},
+			"business": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed: true,
+					},
+					"non_latin_name": schema.StringAttribute{
+						Computed: true,
+					},
+					"company_registration_number": schema.StringAttribute{
+						Computed: true,
+					},
+					"company_tax_identification_number": schema.StringAttribute{
+						Computed: true,
+					},
+					"registered_business_address": schema.SingleNestedAttribute{
+						Computed:   true,
+						Attributes: sellerAddressSchemaAttributes(),
+					},
+				},
+			},
+			"primary_contact": schema.SingleNestedAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:  true,
+						Sensitive: true,
+					},
+					"non_latin_name": schema.StringAttribute{
+						Computed:  true,
+						Sensitive: true,
+					},
+					"address": schema.SingleNestedAttribute{
+						Computed:   true,
+						Sensitive:  true,
+						Attributes: sellerAddressSchemaAttributes(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sellerAccountDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sellerAccountDataSourceModel
+
+	var account struct {
+		Payload *sellerAccount `json:"payload"`
+		Errors  []spapiError   `json:"errors"`
+	}
+
+	err := doSPAPIRequest(ctx, d.sellingPartner.AuthorizeRequest, http.MethodGet,
+		d.region.Endpoint+"/sellers/v1/account", nil, nil, &account)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting seller account", err.Error())
+		return
+	}
+
+	if account.Payload == nil {
+		resp.Diagnostics.AddError("Error getting seller account", "The getAccount response did not contain an account.")
+		return
+	}
+
+	state.MarketplaceIDs = []types.String{}
+	for _, participation := range account.Payload.MarketplaceParticipationList {
+		state.MarketplaceIDs = append(state.MarketplaceIDs, types.StringValue(participation.Marketplace.ID))
+	}
+
+	state.BusinessType = types.StringValue(account.Payload.BusinessType)
+	state.SellingPlan = types.StringValue(account.Payload.SellingPlan)
+
+	if business := account.Payload.Business; business != nil {
+		state.Business = &sellerAccountBusinessModel{
+			Name:                           types.StringValue(business.Name),
+			NonLatinName:                   types.StringPointerValue(business.NonLatinName),
+			CompanyRegistrationNumber:      types.StringPointerValue(business.CompanyRegistrationNumber),
+			CompanyTaxIdentificationNumber: types.StringPointerValue(business.CompanyTaxIdentificationNumber),
+			RegisteredBusinessAddress:      newSellerAddressModel(business.RegisteredBusinessAddress),
+		}
+	}
+
+	if contact := account.Payload.PrimaryContact; contact != nil {
+		state.PrimaryContact = &sellerAccountPrimaryContactModel{
+			Name:         types.StringValue(contact.Name),
+			NonLatinName: types.StringPointerValue(contact.NonLatinName),
+			Address:      newSellerAddressModel(contact.Address),
+		}
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
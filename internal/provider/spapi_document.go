@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// downloadDocument fetches a document from a pre-signed URL returned by the
+// SP-API. The URL carries its own credentials, so the request is not signed.
+func downloadDocument(ctx context.Context, documentURL string) ([]byte, error) {
+	content, _, err := downloadDocumentWithContentType(ctx, documentURL)
+	return content, err
+}
+
+// downloadDocumentWithContentType is downloadDocument that also returns the
+// Content-Type header of the document, which carries its charset.
+func downloadDocumentWithContentType(ctx context.Context, documentURL string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, documentURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return nil, "", fmt.Errorf("downloading document failed with status %d", resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return content, resp.Header.Get("Content-Type"), nil
+}
+
+// uploadDocument sends document content to a pre-signed upload URL. The
+// content type has to match the one the document was created with.
+func uploadDocument(ctx context.Context, documentURL string, contentType string, content []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, documentURL, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("uploading document failed with status %d: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// decompressDocument inflates content compressed with compressionAlgorithm,
+// which is empty for uncompressed documents.
+func decompressDocument(compressionAlgorithm string, content []byte) ([]byte, error) {
+	switch compressionAlgorithm {
+	case "":
+		return content, nil
+	case "GZIP":
+		reader, err := gzip.NewReader(bytes.NewReader(content))
+		if err != nil {
+			return nil, err
+		}
+
+		defer reader.Close()
+
+		return io.ReadAll(reader)
+	}
+
+	return nil, fmt.Errorf("unsupported compression algorithm %q", compressionAlgorithm)
+}
+
+// fetchDocument downloads a document, undoes its compression and converts
+// it to UTF-8 from the charset of its Content-Type.
+func fetchDocument(ctx context.Context, documentURL string, compressionAlgorithm string) ([]byte, error) {
+	content, contentType, err := downloadDocumentWithContentType(ctx, documentURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if content, err = decompressDocument(compressionAlgorithm, content); err != nil {
+		return nil, fmt.Errorf("decompressing document: %w", err)
+	}
+
+	if content, err = decodeDocument(contentType, content); err != nil {
+		return nil, fmt.Errorf("decoding document: %w", err)
+	}
+
+	return content, nil
+}
+
+// decodeDocument converts content to UTF-8 from the charset parameter of
+// contentType. Reports of European marketplaces are, for example, served as
+// text/plain;charset=Cp1252. Content without a charset is returned as is.
+func decodeDocument(contentType string, content []byte) ([]byte, error) {
+	if contentType == "" {
+		return content, nil
+	}
+
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type %q: %w", contentType, err)
+	}
+
+	charset := strings.ToLower(params["charset"])
+	if charset == "" || charset == "utf-8" || charset == "utf8" {
+		return content, nil
+	}
+
+	// Amazon uses the Java names of the Windows code pages, e.g. Cp1252.
+	if number, ok := strings.CutPrefix(charset, "cp"); ok {
+		charset = "windows-" + number
+	}
+
+	encoding, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported charset %q", params["charset"])
+	}
+
+	return encoding.NewDecoder().Bytes(content)
+}
+
+// saveDocument streams a document to outputPath, undoing its compression on
+// the way, and returns the hex SHA-256 checksum of the saved
+// content. The document is written to a temporary file first, so outputPath
+// is either the complete document or left as it was.
+func saveDocument(ctx context.Context, documentURL string, compressionAlgorithm string, outputPath string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, documentURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("downloading document failed with status %d", resp.StatusCode)
+	}
+
+	var reader io.Reader = resp.Body
+
+	switch compressionAlgorithm {
+	case "":
+	case "GZIP":
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return "", fmt.Errorf("decompressing document: %w", err)
+		}
+
+		defer gzipReader.Close()
+
+		reader = gzipReader
+	default:
+		return "", fmt.Errorf("unsupported compression algorithm %q", compressionAlgorithm)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(outputPath), filepath.Base(outputPath)+".*")
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), reader); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	if err := os.Rename(tmp.Name(), outputPath); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// fileChecksum returns the hex SHA-256 checksum of a file.
+func fileChecksum(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
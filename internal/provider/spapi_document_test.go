@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFetchDocumentDecompresses(t *testing.T) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, _ = writer.Write([]byte("report"))
+	_ = writer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(compressed.Bytes())
+	}))
+	defer server.Close()
+
+	content, err := fetchDocument(context.Background(), server.URL, "GZIP")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(content) != "report" {
+		t.Errorf("content = %q, want report", content)
+	}
+}
+
+func TestFetchDocumentDecodesCharset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain;charset=Cp1252")
+		_, _ = w.Write([]byte("sku\tprice\nTASSE-\xc4\t9,99 \x80\n"))
+	}))
+	defer server.Close()
+
+	content, err := fetchDocument(context.Background(), server.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "sku\tprice\nTASSE-Ä\t9,99 €\n"; string(content) != want {
+		t.Errorf("content = %q, want %q", content, want)
+	}
+}
+
+func TestDecodeDocument(t *testing.T) {
+	tests := map[string]struct {
+		contentType string
+		content     string
+		want        string
+		error       bool
+	}{
+		"no content type": {content: "caf\xc3\xa9", want: "café"},
+		"no charset":      {contentType: "text/tab-separated-values", content: "caf\xc3\xa9", want: "café"},
+		"utf-8":           {contentType: "text/plain; charset=UTF-8", content: "caf\xc3\xa9", want: "café"},
+		"cp1252":          {contentType: "text/plain;charset=Cp1252", content: "caf\xe9", want: "café"},
+		"iso-8859-1":      {contentType: "text/plain; charset=ISO-8859-1", content: "caf\xe9", want: "café"},
+		"shift_jis":       {contentType: "text/plain; charset=Shift_JIS", content: "\x83J\x83b\x83v", want: "カップ"},
+		"unknown charset": {contentType: "text/plain; charset=x-unknown", content: "cafe", error: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := decodeDocument(test.contentType, []byte(test.content))
+			if (err != nil) != test.error {
+				t.Fatalf("err = %v", err)
+			}
+
+			if !test.error && string(got) != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSaveDocument(t *testing.T) {
+	content := bytes.Repeat([]byte("sku\tprice\tquantity\n"), 10000)
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, _ = writer.Write(content)
+	_ = writer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(compressed.Bytes())
+	}))
+	defer server.Close()
+
+	outputPath := filepath.Join(t.TempDir(), "reports", "report.tsv")
+
+	checksum, err := saveDocument(context.Background(), server.URL, "GZIP", outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(saved, content) {
+		t.Errorf("saved %d bytes that differ from the content", len(saved))
+	}
+
+	sum := sha256.Sum256(content)
+	if want := hex.EncodeToString(sum[:]); checksum != want {
+		t.Errorf("checksum = %s, want %s", checksum, want)
+	}
+
+	if fileSum, err := fileChecksum(outputPath); err != nil || fileSum != checksum {
+		t.Errorf("file checksum = %s, %v, want %s", fileSum, err, checksum)
+	}
+
+	entries, _ := os.ReadDir(filepath.Dir(outputPath))
+	if len(entries) != 1 {
+		t.Errorf("output directory has %d entries, want only the document", len(entries))
+	}
+}
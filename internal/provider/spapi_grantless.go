@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+)
+
+// grantlessTokens authorizes grantless operations. A SellingPartner caches a
+// single access token, whatever scope it was requested for, and does not
+// lock it, so sharing the seller's SellingPartner would send seller tokens to
+// grantless operations and the other way around. Every scope gets its own
+// SellingPartner instead, and the lock keeps concurrent resources from
+// refreshing a token at the same time.
+type grantlessTokens struct {
+	config *sp.Config
+
+	mu              sync.Mutex
+	sellingPartners map[string]*sp.SellingPartner
+}
+
+func newGrantlessTokens(config *sp.Config) *grantlessTokens {
+	return &grantlessTokens{
+		config:          config,
+		sellingPartners: map[string]*sp.SellingPartner{},
+	}
+}
+
+// authorizer returns a function for doSPAPIRequest that authorizes requests
+// with a grantless token for scope.
+func (g *grantlessTokens) authorizer(scope string) func(*http.Request) error {
+	return func(req *http.Request) error {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		sellingPartner, ok := g.sellingPartners[scope]
+		if !ok {
+			var err error
+			if sellingPartner, err = sp.NewSellingPartner(g.config); err != nil {
+				return err
+			}
+
+			g.sellingPartners[scope] = sellingPartner
+		}
+
+		return sellingPartner.AuthorizeRequestWithScope(req, scope)
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	sp "github.com/brandedtech/sp-api-sdk/pkg/selling-partner"
+)
+
+func TestGrantlessTokensPerScope(t *testing.T) {
+	tokenRequests := 0
+
+	transport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	// The token answered for a grant is named after its scope, or "seller".
+	http.DefaultTransport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		tokenRequests++
+
+		var grant map[string]string
+		if err := json.NewDecoder(req.Body).Decode(&grant); err != nil {
+			t.Fatal(err)
+		}
+
+		token := grant["scope"]
+		if token == "" {
+			token = "seller"
+		}
+
+		body := fmt.Sprintf(`{"access_token": %q, "expires_in": 3600}`, token)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	config := &sp.Config{ClientID: "client", ClientSecret: "secret", RefreshToken: "refresh"}
+
+	sellingPartner, err := sp.NewSellingPartner(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grantless := newGrantlessTokens(config)
+
+	authorize := func(authorize func(*http.Request) error) string {
+		req, _ := http.NewRequest(http.MethodGet, "https://sellingpartnerapi-na.amazon.com/", nil)
+		if err := authorize(req); err != nil {
+			t.Fatal(err)
+		}
+
+		return req.Header.Get("X-Amz-Access-Token")
+	}
+
+	for i := 0; i < 2; i++ {
+		if got := authorize(sellingPartner.AuthorizeRequest); got != "seller" {
+			t.Errorf("seller request got token %q", got)
+		}
+
+		if got := authorize(grantless.authorizer("sellingpartnerapi::notifications")); got != "sellingpartnerapi::notifications" {
+			t.Errorf("notifications request got token %q", got)
+		}
+
+		if got := authorize(grantless.authorizer(applicationClientSecretRotationScope)); got != applicationClientSecretRotationScope {
+			t.Errorf("rotation request got token %q", got)
+		}
+	}
+
+	if tokenRequests != 3 {
+		t.Errorf("got %d token requests, want one per scope and the seller", tokenRequests)
+	}
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// spapiError is the error object shared by all SP-API operations.
+type spapiError struct {
+	Code    string  `json:"code"`
+	Message string  `json:"message"`
+	Details *string `json:"details,omitempty"`
+}
+
+// spapiResponseError is returned by doSPAPIRequest when the SP-API answers
+// with a non-2xx status code.
+type spapiResponseError struct {
+	StatusCode int
+	Errors     []spapiError
+}
+
+func (e *spapiResponseError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("SP-API request failed with status %d", e.StatusCode)
+	}
+
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", err.Code, err.Message))
+	}
+
+	return fmt.Sprintf("SP-API request failed with status %d: %s", e.StatusCode, strings.Join(messages, "; "))
+}
+
+// isSPAPINotFound reports whether err is a 404 response from the SP-API.
+func isSPAPINotFound(err error) bool {
+	respErr, ok := err.(*spapiResponseError)
+	return ok && respErr.StatusCode == http.StatusNotFound
+}
+
+// spapiMaxRetries is how often doSPAPIRequest retries a throttled request.
+const spapiMaxRetries = 5
+
+// spapiMaxRetryDelay caps the wait between retries of a throttled request.
+const spapiMaxRetryDelay = 2 * time.Minute
+
+// spapiRetryDelay returns how long to wait before retrying a request the
+// SP-API throttled with a 429 response. The x-amzn-RateLimit-Limit header
+// holds the sustained rate of the operation in requests per second, so one
+// request becomes available again after its inverse. Without the header the
+// delay doubles with every attempt, starting at one second.
+func spapiRetryDelay(header http.Header, attempt int) time.Duration {
+	delay := time.Second << attempt
+
+	if limit, err := strconv.ParseFloat(header.Get("x-amzn-RateLimit-Limit"), 64); err == nil && limit > 0 {
+		delay = time.Duration(float64(time.Second) / limit)
+	}
+
+	return min(delay, spapiMaxRetryDelay)
+}
+
+// doSPAPIRequest sends a JSON request to an SP-API operation that has no
+// generated client in the SDK and decodes the response body into out.
+// authorize is typically SellingPartner.AuthorizeRequest or a closure around
+// SellingPartner.AuthorizeRequestWithScope for grantless operations. Throttled
+// requests are retried, see spapiRetryDelay.
+func doSPAPIRequest(ctx context.Context, authorize func(*http.Request) error, method string, endpoint string, query url.Values, body any, out any) error {
+	var reqBody []byte
+
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request body: %w", err)
+		}
+
+		reqBody = buf
+	}
+
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	for attempt := 0; ; attempt++ {
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(reqBody)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, bodyReader)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("Accept", "application/json")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		if err := authorize(req); err != nil {
+			return err
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < spapiMaxRetries {
+			delay := spapiRetryDelay(resp.Header, attempt)
+
+			tflog.Debug(ctx, "SP-API request throttled, retrying", map[string]interface{}{"endpoint": endpoint, "delay": delay.String()})
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+
+			continue
+		}
+
+		if resp.StatusCode >= 300 {
+			var errorList struct {
+				Errors []spapiError `json:"errors"`
+			}
+
+			// The error body is informative only, a malformed one still yields the status code.
+			_ = json.Unmarshal(respBody, &errorList)
+
+			return &spapiResponseError{
+				StatusCode: resp.StatusCode,
+				Errors:     errorList.Errors,
+			}
+		}
+
+		if out == nil || len(respBody) == 0 {
+			return nil
+		}
+
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("decoding response body: %w", err)
+		}
+
+		return nil
+	}
+}
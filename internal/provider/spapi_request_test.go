@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSPAPIRetryDelay(t *testing.T) {
+	tests := []struct {
+		limit   string
+		attempt int
+		want    time.Duration
+	}{
+		{limit: "", attempt: 0, want: time.Second},
+		{limit: "", attempt: 3, want: 8 * time.Second},
+		{limit: "", attempt: 10, want: spapiMaxRetryDelay},
+		{limit: "5.0", attempt: 0, want: 200 * time.Millisecond},
+		{limit: "0.025", attempt: 2, want: 40 * time.Second},
+		{limit: "0.0055", attempt: 0, want: spapiMaxRetryDelay},
+		{limit: "invalid", attempt: 1, want: 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		header := http.Header{}
+		if tt.limit != "" {
+			header.Set("x-amzn-RateLimit-Limit", tt.limit)
+		}
+
+		if got := spapiRetryDelay(header, tt.attempt); got != tt.want {
+			t.Errorf("spapiRetryDelay(%q, %d) = %s, want %s", tt.limit, tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestDoSPAPIRequestRetriesThrottledRequests(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if requests < 3 {
+			w.Header().Set("x-amzn-RateLimit-Limit", "100")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"errors":[{"code":"QuotaExceeded","message":"You exceeded your quota for the requested resource."}]}`))
+			return
+		}
+
+		w.Write([]byte(`{"payload":"ok"}`))
+	}))
+	defer server.Close()
+
+	authorize := func(*http.Request) error { return nil }
+
+	var out struct {
+		Payload string `json:"payload"`
+	}
+
+	if err := doSPAPIRequest(context.Background(), authorize, http.MethodPost, server.URL, nil, map[string]string{"a": "b"}, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 3 || out.Payload != "ok" {
+		t.Errorf("got %d requests and payload %q", requests, out.Payload)
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sellingPartnerNotificationsPrincipal is the AWS account that delivers
+// SP-API notifications to SQS destinations.
+const sellingPartnerNotificationsPrincipal = "arn:aws:iam::437568002678:root"
+
+const defaultSQSDestinationPolicyStatementID = "AllowSellingPartnerNotifications"
+
+var (
+	_ datasource.DataSource = &sqsDestinationPolicyDocumentDatasource{}
+)
+
+func NewSQSDestinationPolicyDocumentDatasource() datasource.DataSource {
+	return &sqsDestinationPolicyDocumentDatasource{}
+}
+
+// sqsDestinationPolicyDocumentDatasource renders the queue policy an SQS
+// queue needs before it can be used in spapi_notification_destination.
+type sqsDestinationPolicyDocumentDatasource struct{}
+
+type sqsDestinationPolicyDocumentDataSourceModel struct {
+	QueueARN    types.String `tfsdk:"queue_arn"`
+	StatementID types.String `tfsdk:"statement_id"`
+	JSON        types.String `tfsdk:"json"`
+}
+
+type iamPolicyDocument struct {
+	Version   string               `json:"Version"`
+	Statement []iamPolicyStatement `json:"Statement"`
+}
+
+type iamPolicyStatement struct {
+	Sid       string            `json:"Sid"`
+	Effect    string            `json:"Effect"`
+	Principal map[string]string `json:"Principal"`
+	Action    []string          `json:"Action"`
+	Resource  string            `json:"Resource"`
+}
+
+// sqsDestinationPolicyDocument returns the queue policy JSON that allows the
+// SP-API notifications principal to deliver messages to queueARN.
+func sqsDestinationPolicyDocument(queueARN string, statementID string) (string, error) {
+	document := iamPolicyDocument{
+		Version: "2012-10-17",
+		Statement: []iamPolicyStatement{
+			{
+				Sid:       statementID,
+				Effect:    "Allow",
+				Principal: map[string]string{"AWS": sellingPartnerNotificationsPrincipal},
+				Action:    []string{"sqs:GetQueueAttributes", "sqs:SendMessage"},
+				Resource:  queueARN,
+			},
+		},
+	}
+
+	buf, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
+
+func (d *sqsDestinationPolicyDocumentDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sqs_destination_policy_document"
+}
+
+func (d *sqsDestinationPolicyDocumentDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"queue_arn": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sqsQueueARNValidator{},
+				},
+			},
+			"statement_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Sid of the policy statement. Defaults to " + defaultSQSDestinationPolicyStatementID + ".",
+			},
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "Queue policy JSON, suitable for the policy argument of aws_sqs_queue_policy.",
+			},
+		},
+	}
+}
+
+func (d *sqsDestinationPolicyDocumentDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sqsDestinationPolicyDocumentDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statementID := defaultSQSDestinationPolicyStatementID
+	if !state.StatementID.IsNull() {
+		statementID = state.StatementID.ValueString()
+	}
+
+	document, err := sqsDestinationPolicyDocument(state.QueueARN.ValueString(), statementID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering SQS destination policy document", err.Error())
+		return
+	}
+
+	state.StatementID = types.StringValue(statementID)
+	state.JSON = types.StringValue(document)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
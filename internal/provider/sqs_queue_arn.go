@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var sqsQueueARNPattern = regexp.MustCompile(`^arn:(aws|aws-cn|aws-us-gov):sqs:([a-z]{2}(?:-gov)?-[a-z]+-\d):(\d{12}):([A-Za-z0-9_-]{1,80}(?:\.fifo)?)$`)
+
+// sqsQueueARN is the parsed form of an Amazon SQS queue ARN.
+type sqsQueueARN struct {
+	Partition string
+	Region    string
+	AccountID string
+	QueueName string
+}
+
+func parseSQSQueueARN(arn string) (sqsQueueARN, error) {
+	matches := sqsQueueARNPattern.FindStringSubmatch(arn)
+	if matches == nil {
+		return sqsQueueARN{}, fmt.Errorf("%q is not a valid SQS queue ARN, expected arn:aws:sqs:<region>:<account id>:<queue name>", arn)
+	}
+
+	return sqsQueueARN{
+		Partition: matches[1],
+		Region:    matches[2],
+		AccountID: matches[3],
+		QueueName: matches[4],
+	}, nil
+}
+
+var _ validator.String = sqsQueueARNValidator{}
+
+// sqsQueueARNValidator checks that a string is a well-formed SQS queue ARN.
+// The region of the queue can only be compared with the provider region once
+// the provider is configured, see notificationDestinationResource.ModifyPlan.
+type sqsQueueARNValidator struct{}
+
+func (v sqsQueueARNValidator) Description(_ context.Context) string {
+	return "value must be an SQS queue ARN"
+}
+
+func (v sqsQueueARNValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sqsQueueARNValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseSQSQueueARN(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid SQS queue ARN", err.Error())
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseSQSQueueARN(t *testing.T) {
+	arn, err := parseSQSQueueARN("arn:aws:sqs:us-east-1:123456789012:sp-api-notifications")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if arn.Region != "us-east-1" || arn.AccountID != "123456789012" || arn.QueueName != "sp-api-notifications" {
+		t.Errorf("unexpected parse result: %+v", arn)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"arn:aws:sns:us-east-1:123456789012:topic",
+		"arn:aws:sqs:us-east-1:1234:queue",
+		"https://sqs.us-east-1.amazonaws.com/123456789012/queue",
+	} {
+		if _, err := parseSQSQueueARN(invalid); err == nil {
+			t.Errorf("parseSQSQueueARN(%q) did not return an error", invalid)
+		}
+	}
+}
+
+func TestSQSDestinationPolicyDocument(t *testing.T) {
+	queueARN := "arn:aws:sqs:eu-west-1:123456789012:notifications"
+
+	document, err := sqsDestinationPolicyDocument(queueARN, "sid")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var policy iamPolicyDocument
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		t.Fatalf("policy is not valid JSON: %s", err)
+	}
+
+	statement := policy.Statement[0]
+	if statement.Resource != queueARN || statement.Principal["AWS"] != sellingPartnerNotificationsPrincipal {
+		t.Errorf("unexpected statement: %+v", statement)
+	}
+
+	if len(statement.Action) != 2 || statement.Action[0] != "sqs:GetQueueAttributes" || statement.Action[1] != "sqs:SendMessage" {
+		t.Errorf("unexpected actions: %v", statement.Action)
+	}
+}
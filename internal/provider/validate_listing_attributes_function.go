@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = validateListingAttributesFunction{}
+)
+
+var listingSchemaViolationAttributeTypes = map[string]attr.Type{
+	"path":    types.StringType,
+	"message": types.StringType,
+}
+
+var listingSchemaResultAttributeTypes = map[string]attr.Type{
+	"valid": types.BoolType,
+	"errors": types.ListType{
+		ElemType: types.ObjectType{AttrTypes: listingSchemaViolationAttributeTypes},
+	},
+	"non_editable_paths": types.ListType{ElemType: types.StringType},
+	"hidden_paths":       types.ListType{ElemType: types.StringType},
+}
+
+type listingSchemaViolationModel struct {
+	Path    types.String `tfsdk:"path"`
+	Message types.String `tfsdk:"message"`
+}
+
+type listingSchemaResultModel struct {
+	Valid            types.Bool                    `tfsdk:"valid"`
+	Errors           []listingSchemaViolationModel `tfsdk:"errors"`
+	NonEditablePaths []types.String                `tfsdk:"non_editable_paths"`
+	HiddenPaths      []types.String                `tfsdk:"hidden_paths"`
+}
+
+func newListingSchemaResultModel(result *listingSchemaResult) listingSchemaResultModel {
+	model := listingSchemaResultModel{
+		Valid:            types.BoolValue(len(result.Violations) == 0),
+		Errors:           make([]listingSchemaViolationModel, 0, len(result.Violations)),
+		NonEditablePaths: make([]types.String, 0, len(result.NonEditable)),
+		HiddenPaths:      make([]types.String, 0, len(result.Hidden)),
+	}
+
+	for _, violation := range result.Violations {
+		model.Errors = append(model.Errors, listingSchemaViolationModel{
+			Path:    types.StringValue(violation.Path),
+			Message: types.StringValue(violation.Message),
+		})
+	}
+
+	for _, path := range result.NonEditable {
+		model.NonEditablePaths = append(model.NonEditablePaths, types.StringValue(path))
+	}
+
+	for _, path := range result.Hidden {
+		model.HiddenPaths = append(model.HiddenPaths, types.StringValue(path))
+	}
+
+	return model
+}
+
+// NewValidateListingAttributesFunction is a helper function to simplify the provider implementation.
+func NewValidateListingAttributesFunction() function.Function {
+	return validateListingAttributesFunction{}
+}
+
+type validateListingAttributesFunction struct{}
+
+func (f validateListingAttributesFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_listing_attributes"
+}
+
+func (f validateListingAttributesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate listing attributes against a product type schema",
+		MarkdownDescription: "Validates listing attributes locally against the JSON schema of a product type, as returned " +
+			"by the `schema_json` attribute of the `spapi_product_type_definition` data source, without calling the " +
+			"SP-API. Besides standard JSON Schema keywords, Amazon's `selectors` and `maxUniqueItems` are enforced. " +
+			"Values whose schema is marked `editable: false` or `hidden: true` are reported in `non_editable_paths` " +
+			"and `hidden_paths`. Paths are JSON pointers into the attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schema_json",
+				MarkdownDescription: "Product type JSON schema.",
+			},
+			function.StringParameter{
+				Name:                "attributes_json",
+				MarkdownDescription: "Listing attributes as a JSON object.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: listingSchemaResultAttributeTypes,
+		},
+	}
+}
+
+func (f validateListingAttributesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schemaJSON, attributesJSON string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &schemaJSON, &attributesJSON))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := decodeJSONNumbers([]byte(schemaJSON)); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "schema_json is not valid JSON: "+err.Error())
+		return
+	}
+
+	if _, err := decodeListingAttributes(attributesJSON); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := validateListingSchema([]byte(schemaJSON), []byte(attributesJSON))
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, newListingSchemaResultModel(result)))
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func runValidateListingAttributesFunction(t *testing.T, schemaJSON string, attributesJSON string) (*function.RunResponse, listingSchemaResultModel) {
+	t.Helper()
+
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(listingSchemaResultAttributeTypes)),
+	}
+
+	validateListingAttributesFunction{}.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(schemaJSON), types.StringValue(attributesJSON)}),
+	}, resp)
+
+	var result listingSchemaResultModel
+	if resp.Error == nil {
+		object, ok := resp.Result.Value().(types.Object)
+		if !ok {
+			t.Fatalf("result is %T, want an object", resp.Result.Value())
+		}
+
+		if diags := object.As(context.Background(), &result, basetypes.ObjectAsOptions{}); diags.HasError() {
+			t.Fatalf("decoding result: %v", diags)
+		}
+	}
+
+	return resp, result
+}
+
+func TestValidateListingAttributesFunction(t *testing.T) {
+	schemaJSON, err := os.ReadFile("testdata/product_type_schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, result := runValidateListingAttributesFunction(t, string(schemaJSON), `{"item_name": [{"value": 1}]}`)
+	if resp.Error != nil {
+		t.Fatal(resp.Error)
+	}
+
+	if result.Valid.ValueBool() || len(result.Errors) == 0 {
+		t.Errorf("got valid %s with %d errors, want invalid attributes", result.Valid, len(result.Errors))
+	}
+
+	if _, result := runValidateListingAttributesFunction(t, `{"type": "object"}`, `{}`); !result.Valid.ValueBool() || len(result.Errors) != 0 {
+		t.Errorf("got valid %s with errors %v, want valid attributes", result.Valid, result.Errors)
+	}
+
+	if resp, _ := runValidateListingAttributesFunction(t, `{"type": `, `{}`); resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("got error %v, want an error for schema_json", resp.Error)
+	}
+
+	if resp, _ := runValidateListingAttributesFunction(t, `{}`, `[]`); resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 1 {
+		t.Errorf("got error %v, want an error for attributes_json", resp.Error)
+	}
+}